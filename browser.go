@@ -20,6 +20,10 @@ import (
 
 // Retrieve browser name from UA strings
 func (u *UserAgent) evalBrowserName(ua string) bool {
+	if u.evalRegisteredBrowser(ua) {
+		return u.maybeBot()
+	}
+
 	// Blackberry goes first because it reads as MSIE & Safari
 	if strings.Contains(ua, "blackberry") || strings.Contains(ua, "playbook") || strings.Contains(ua, "bb10") || strings.Contains(ua, "rim ") {
 		u.Browser.Name = BrowserBlackberry
@@ -27,6 +31,19 @@ func (u *UserAgent) evalBrowserName(ua string) bool {
 	}
 
 	if strings.Contains(ua, "applewebkit") {
+		// stock Chrome and Safari skip the checks for the browsers built on them
+		if stockWebKit(ua) {
+			switch {
+			case strings.Contains(ua, "chrome/"):
+				u.Browser.Name = BrowserChrome
+				return u.maybeBot()
+
+			case strings.Contains(ua, "like gecko") && strings.Contains(ua, "mozilla/") && strings.Contains(ua, "safari/") && !strings.Contains(ua, "linux") && !strings.Contains(ua, "android"):
+				u.Browser.Name = BrowserSafari
+				return u.maybeBot()
+			}
+		}
+
 		switch {
 		case strings.Contains(ua, "googlebot"):
 			u.Browser.Name = BrowserGoogleBot
@@ -34,17 +51,35 @@ func (u *UserAgent) evalBrowserName(ua string) bool {
 		case strings.Contains(ua, "qq/") || strings.Contains(ua, "qqbrowser/"):
 			u.Browser.Name = BrowserQQ
 
+		case strings.Contains(ua, "opx/") || strings.Contains(ua, "oprgx/") || strings.Contains(ua, "edition gx"):
+			u.Browser.Name = BrowserOperaGX
+
+		// Opera Mini's client-rendered mode is a Chromium web view
+		case strings.Contains(ua, "opim/"):
+			u.Browser.Name = BrowserOperaMini
+
+		case strings.Contains(ua, "puffin/"):
+			u.Browser.Name = BrowserPuffin
+			u.Browser.ServerRendered = true
+
 		case strings.Contains(ua, "opr/") || strings.Contains(ua, "opios/"):
 			u.Browser.Name = BrowserOpera
 
 		case strings.Contains(ua, "silk/"):
 			u.Browser.Name = BrowserSilk
+			u.Browser.ServerRendered = strings.Contains(ua, "silk-accelerated=true") || strings.Contains(ua, "silk-acceleratedtrue")
 
 		case strings.Contains(ua, "edg/") || strings.Contains(ua, "edgios/") || strings.Contains(ua, "edga/")|| strings.Contains(ua, "edge/") || strings.Contains(ua, "iemobile/") || strings.Contains(ua, "msie "):
 			u.Browser.Name = BrowserIE
 
-		case strings.Contains(ua, "ucbrowser/") || strings.Contains(ua, "ucweb/"):
+		case strings.Contains(ua, "ucmini/"):
+			u.Browser.Name = BrowserUCMini
+			u.Browser.ServerRendered = true
+
+		// the U2 engine renders on UC's servers, U3 and U4 on the device
+		case strings.Contains(ua, "ucbrowser/") || strings.Contains(ua, "ucweb/") || strings.Contains(ua, " ubrowser/") || strings.Contains(ua, "ucturbo/"):
 			u.Browser.Name = BrowserUCBrowser
+			u.Browser.ServerRendered = strings.Contains(ua, " u2/")
 
 		case strings.Contains(ua, "nintendobrowser/"):
 			u.Browser.Name = BrowserNintendo
@@ -58,6 +93,72 @@ func (u *UserAgent) evalBrowserName(ua string) bool {
 		case strings.Contains(ua, "yabrowser/"):
 			u.Browser.Name = BrowserYandex
 
+		case strings.Contains(ua, "maxthon") || strings.Contains(ua, "mxbrowser/"):
+			u.Browser.Name = BrowserMaxthon
+
+		case strings.Contains(ua, "metasr") || strings.Contains(ua, "sogoumobilebrowser/"):
+			u.Browser.Name = BrowserSogouExplorer
+
+		case strings.Contains(ua, "nokiabrowser/") || strings.Contains(ua, "browserng/"):
+			u.Browser.Name = BrowserNokia
+
+		case strings.Contains(ua, "netfront/"):
+			u.Browser.Name = BrowserNetFront
+
+		case strings.Contains(ua, "baiduboxapp/") || strings.Contains(ua, "bidubrowser/") || strings.Contains(ua, "baidubrowser/"):
+			u.Browser.Name = BrowserBaidu
+
+		case strings.Contains(ua, "360se") || strings.Contains(ua, "360ee") || strings.Contains(ua, "qihoobrowser/"):
+			u.Browser.Name = Browser360
+
+		case strings.Contains(ua, "quark/"):
+			u.Browser.Name = BrowserQuark
+
+		case strings.Contains(ua, "miuibrowser/"):
+			u.Browser.Name = BrowserMIUI
+
+		case strings.Contains(ua, "vivobrowser/"):
+			u.Browser.Name = BrowserVivo
+
+		case strings.Contains(ua, "heytapbrowser/"):
+			u.Browser.Name = BrowserHeyTap
+
+		case strings.Contains(ua, "lbbrowser"):
+			u.Browser.Name = BrowserLiebao
+
+		case strings.Contains(ua, "2345explorer/") || strings.Contains(ua, "2345browser/"):
+			u.Browser.Name = Browser2345
+
+		case strings.Contains(ua, "huaweibrowser/"):
+			u.Browser.Name = BrowserHuawei
+
+		// ArkWeb is the OpenHarmony web engine, seen here without a known browser on top
+		case strings.Contains(ua, "arkweb/"):
+			u.Browser.Name = BrowserArkWeb
+
+		case strings.Contains(ua, "vivaldi/"):
+			u.Browser.Name = BrowserVivaldi
+
+		case strings.Contains(ua, "whale/"):
+			u.Browser.Name = BrowserWhale
+
+		// Brave only identifies itself in client hints, except for some older builds
+		case strings.Contains(ua, "brave/") || strings.Contains(ua, " brave "):
+			u.Browser.Name = BrowserBrave
+
+		case strings.Contains(ua, " arc/"):
+			u.Browser.Name = BrowserArc
+
+		case strings.Contains(ua, "duckduckgo/") || strings.Contains(ua, " ddg/"):
+			u.Browser.Name = BrowserDuckDuckGo
+
+		case strings.Contains(ua, "ecosia"):
+			u.Browser.Name = BrowserEcosia
+
+		case strings.Contains(ua, " focus/") || strings.Contains(ua, " klar/"):
+			u.Browser.Name = BrowserFirefoxFocus
+			u.Browser.Engine = webViewEngine(ua)
+
 		// Edge, Silk and other chrome-identifying browsers must evaluate before chrome, unless we want to add more overhead
 		case strings.Contains(ua, "chrome/") || strings.Contains(ua, "crios/") || strings.Contains(ua, "chromium/") || strings.Contains(ua, "crmo/"):
 			u.Browser.Name = BrowserChrome
@@ -72,6 +173,10 @@ func (u *UserAgent) evalBrowserName(ua string) bool {
 		case strings.Contains(ua, " spotify/"):
 			u.Browser.Name = BrowserSpotify
 
+		// Apple Mail on macOS sends a bare webkit signature with its own build number
+		case strings.Contains(ua, " mail/"):
+			u.Browser.Name = BrowserAppleMail
+
 		// AppleBot uses webkit signature as well
 		case strings.Contains(ua, "applebot"):
 			u.Browser.Name = BrowserAppleBot
@@ -92,6 +197,9 @@ func (u *UserAgent) evalBrowserName(ua string) bool {
 			goto notwebkit
 
 		}
+		if dualEngine(u.Browser.Name) {
+			u.Browser.Engine = webViewEngine(ua)
+		}
 		return u.maybeBot()
 	}
 
@@ -100,17 +208,129 @@ notwebkit:
 	case strings.Contains(ua, "qq/") || strings.Contains(ua, "qqbrowser/"):
 		u.Browser.Name = BrowserQQ
 
+	// Mail proxies claim a desktop OS that has nothing to do with the recipient
+	case strings.Contains(ua, "googleimageproxy"):
+		u.Browser.Name = BrowserGoogleImageProxy
+		u.OS = OS{}
+
+	case strings.Contains(ua, "yahoomailproxy"):
+		u.Browser.Name = BrowserYahooMailProxy
+		u.OS = OS{}
+
+	// Outlook on Windows reads as MSIE
+	case strings.Contains(ua, "microsoft outlook"):
+		u.Browser.Name = BrowserOutlook
+
+	case strings.Contains(ua, "thunderbird/"):
+		u.Browser.Name = BrowserThunderbird
+
+	// Maxthon and Sogou Explorer wrap Trident and read as MSIE
+	case strings.Contains(ua, "maxthon"):
+		u.Browser.Name = BrowserMaxthon
+
+	case strings.Contains(ua, "metasr"):
+		u.Browser.Name = BrowserSogouExplorer
+
+	// Chinese browsers offering an IE compatibility mode
+	case strings.Contains(ua, "bidubrowser/") || strings.Contains(ua, "baidubrowser/"):
+		u.Browser.Name = BrowserBaidu
+
+	case strings.Contains(ua, "360se") || strings.Contains(ua, "360ee"):
+		u.Browser.Name = Browser360
+
+	case strings.Contains(ua, "lbbrowser"):
+		u.Browser.Name = BrowserLiebao
+
+	case strings.Contains(ua, "2345explorer/"):
+		u.Browser.Name = Browser2345
+
+	case strings.Contains(ua, "s40ovibrowser/") || strings.Contains(ua, "nokiabrowser/") || strings.Contains(ua, "browserng/"):
+		u.Browser.Name = BrowserNokia
+
+	case strings.Contains(ua, "netfront/"):
+		u.Browser.Name = BrowserNetFront
+
 	case strings.Contains(ua, "msie") || strings.Contains(ua, "trident"):
 		u.Browser.Name = BrowserIE
 
-	case strings.Contains(ua, "gecko") && (strings.Contains(ua, "firefox") || strings.Contains(ua, "iceweasel") || strings.Contains(ua, "seamonkey") || strings.Contains(ua, "icecat")):
+	// Firefox forks, most of which also claim to be Firefox
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "iceweasel"):
+		u.Browser.Name = BrowserIceweasel
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "seamonkey"):
+		u.Browser.Name = BrowserSeaMonkey
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "icecat"):
+		u.Browser.Name = BrowserIceCat
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "waterfox/"):
+		u.Browser.Name = BrowserWaterfox
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "librewolf/"):
+		u.Browser.Name = BrowserLibreWolf
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "palemoon/"):
+		u.Browser.Name = BrowserPaleMoon
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "basilisk/"):
+		u.Browser.Name = BrowserBasilisk
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "k-meleon/"):
+		u.Browser.Name = BrowserKMeleon
+
+	case strings.Contains(ua, "gecko") && (strings.Contains(ua, " focus/") || strings.Contains(ua, " klar/")):
+		u.Browser.Name = BrowserFirefoxFocus
+		u.Browser.Engine = FamilyGecko
+
+	case strings.Contains(ua, "gecko") && strings.Contains(ua, "firefox"):
 		u.Browser.Name = BrowserFirefox
 
+	// Opera Mini must evaluate before the other Presto browsers
+	case strings.Contains(ua, "opera mini/"):
+		u.Browser.Name = BrowserOperaMini
+		u.Browser.ServerRendered = true
+
 	case strings.Contains(ua, "presto") || strings.Contains(ua, "opera"):
 		u.Browser.Name = BrowserOpera
 
+	case strings.Contains(ua, "ucmini/"):
+		u.Browser.Name = BrowserUCMini
+		u.Browser.ServerRendered = true
+
 	case strings.Contains(ua, "ucbrowser"):
 		u.Browser.Name = BrowserUCBrowser
+		u.Browser.ServerRendered = strings.Contains(ua, " u2/")
+
+	// Media players and podcast apps, see https://iabtechlab.com/standards/podcast-measurement-guidelines/
+	case strings.Contains(ua, "applecoremedia/"):
+		u.Browser.Name = BrowserAppleCoreMedia
+
+	case strings.Contains(ua, "exoplayerlib/") || strings.Contains(ua, "androidxmedia3/"):
+		u.Browser.Name = BrowserExoPlayer
+
+	case strings.Contains(ua, "stagefright/"):
+		u.Browser.Name = BrowserStagefright
+
+	case strings.Contains(ua, "vlc/") || strings.Contains(ua, "libvlc/"):
+		u.Browser.Name = BrowserVLC
+
+	case strings.HasPrefix(ua, "lavf"):
+		u.Browser.Name = BrowserFFmpeg
+
+	case strings.Contains(ua, "roku") && strings.Contains(ua, "/dvp-"):
+		u.Browser.Name = BrowserRoku
+
+	case strings.Contains(ua, "overcast/"):
+		u.Browser.Name = BrowserOvercast
+
+	case strings.Contains(ua, "pocketcasts") || strings.Contains(ua, "pocket casts"):
+		u.Browser.Name = BrowserPocketCasts
+
+	case strings.Contains(ua, "itunes/"):
+		u.Browser.Name = BrowseriTunes
+
+	case strings.Contains(ua, "spotify/"):
+		u.Browser.Name = BrowserSpotify
 
 	case strings.Contains(ua, "applebot"):
 		u.Browser.Name = BrowserAppleBot
@@ -158,21 +378,48 @@ notwebkit:
 		u.Browser.Name = BrowserUnknown
 
 	}
+	if dualEngine(u.Browser.Name) && (strings.Contains(ua, "msie") || strings.Contains(ua, "trident")) {
+		u.Browser.Engine = FamilyTrident
+	}
 
 	return u.maybeBot()
 }
 
+// dualEngine reports whether b ships on Trident as well as on WebKit or
+// Chromium, as Maxthon, QQ and other Chinese browsers do for sites that need
+// Internet Explorer.
+func dualEngine(b BrowserName) bool {
+	switch b {
+	case BrowserMaxthon, BrowserQQ, BrowserSogouExplorer, BrowserBaidu, Browser360, BrowserLiebao, Browser2345:
+		return true
+	}
+	return false
+}
+
 // Retrieve browser version
 // Methods used in order:
 // 1st: look for generic version/#
 // 2nd: look for browser-specific instructions (e.g. chrome/34)
 // 3rd: infer from OS (iOS only)
 func (u *UserAgent) evalBrowserVersion(ua string) {
-	// if there is a 'version/#' attribute with numeric version, use it -- except for Chrome since Android vendors sometimes hijack version/#
-	if u.Browser.Name != BrowserChrome && u.Browser.Version.findVersionNumber(ua, "version/") {
+	// registered browsers read their version as they are matched
+	if _, ok := registered().browser(u.Browser.Name); ok {
 		return
 	}
 
+	// if there is a 'version/#' attribute with numeric version, use it -- except for Chrome and browsers
+	// built on Android WebView, since Android vendors sometimes hijack version/#
+	switch u.Browser.Name {
+	case BrowserChrome, BrowserHuawei, BrowserArkWeb, BrowserMaxthon, BrowserSogouExplorer, BrowserNokia, BrowserNetFront,
+		BrowserVivaldi, BrowserWhale, BrowserOperaGX, BrowserBrave, BrowserArc, BrowserDuckDuckGo, BrowserEcosia, BrowserFirefoxFocus,
+		BrowserBaidu, Browser360, BrowserQuark, BrowserMIUI, BrowserVivo, BrowserHeyTap, BrowserLiebao, Browser2345, BrowserUCBrowser,
+		BrowserOperaMini, BrowserPuffin, BrowserUCMini:
+	default:
+		if u.Browser.Version.findVersionNumber(ua, "version/") {
+			return
+		}
+	}
+
 	switch u.Browser.Name {
 	case BrowserChrome:
 		// match both chrome and crios
@@ -200,6 +447,38 @@ func (u *UserAgent) evalBrowserVersion(ua string) {
 	case BrowserFirefox:
 		_ = u.Browser.Version.findVersionNumber(ua, "firefox/") || u.Browser.Version.findVersionNumber(ua, "fxios/")
 
+	case BrowserIceweasel:
+		_ = u.Browser.Version.findVersionNumber(ua, "iceweasel/")
+
+	case BrowserSeaMonkey:
+		_ = u.Browser.Version.findVersionNumber(ua, "seamonkey/")
+
+	case BrowserIceCat:
+		_ = u.Browser.Version.findVersionNumber(ua, "icecat/")
+
+	case BrowserWaterfox:
+		// the current Waterfox prefixes its version with G, e.g. waterfox/g6.0.5
+		_ = u.Browser.Version.findVersionNumber(ua, "waterfox/g") || u.Browser.Version.findVersionNumber(ua, "waterfox/")
+
+	case BrowserLibreWolf:
+		_ = u.Browser.Version.findVersionNumber(ua, "librewolf/")
+
+	case BrowserPaleMoon:
+		_ = u.Browser.Version.findVersionNumber(ua, "palemoon/")
+
+	case BrowserBasilisk:
+		// Basilisk reports its build date, e.g. basilisk/20230213, which is
+		// kept as the build number rather than the major version
+		if u.Browser.Version.findVersionNumber(ua, "basilisk/") && u.Browser.Version.Major >= 10000000 {
+			u.Browser.Version = Version{Build: u.Browser.Version.Major, Raw: u.Browser.Version.Raw}
+		}
+
+	case BrowserKMeleon:
+		_ = u.Browser.Version.findVersionNumber(ua, "k-meleon/")
+
+	case BrowserFirefoxFocus:
+		_ = u.Browser.Version.findVersionNumber(ua, " focus/") || u.Browser.Version.findVersionNumber(ua, " klar/")
+
 	case BrowserSafari: // executes typically if we're on iOS and not using a familiar browser
 		u.Browser.Version = u.OS.Version
 		// early Safari used a version number +1 to OS version
@@ -208,7 +487,29 @@ func (u *UserAgent) evalBrowserVersion(ua string) {
 		}
 
 	case BrowserUCBrowser:
-		_ = u.Browser.Version.findVersionNumber(ua, "ucbrowser/")
+		_ = u.Browser.Version.findVersionNumber(ua, "ucbrowser/") || u.Browser.Version.findVersionNumber(ua, " ubrowser/") || u.Browser.Version.findVersionNumber(ua, "ucturbo/")
+
+	case BrowserBaidu:
+		_ = u.Browser.Version.findVersionNumber(ua, "baiduboxapp/") || u.Browser.Version.findVersionNumber(ua, "bidubrowser/") || u.Browser.Version.findVersionNumber(ua, "baidubrowser/")
+
+	case Browser360:
+		// only the mobile browser reports a version
+		_ = u.Browser.Version.findVersionNumber(ua, "qihoobrowser/")
+
+	case BrowserQuark:
+		_ = u.Browser.Version.findVersionNumber(ua, "quark/")
+
+	case BrowserMIUI:
+		_ = u.Browser.Version.findVersionNumber(ua, "miuibrowser/")
+
+	case BrowserVivo:
+		_ = u.Browser.Version.findVersionNumber(ua, "vivobrowser/")
+
+	case BrowserHeyTap:
+		_ = u.Browser.Version.findVersionNumber(ua, "heytapbrowser/")
+
+	case Browser2345:
+		_ = u.Browser.Version.findVersionNumber(ua, "2345explorer/") || u.Browser.Version.findVersionNumber(ua, "2345browser/")
 
 	case BrowserOpera:
 		_ = u.Browser.Version.findVersionNumber(ua, "opr/") || u.Browser.Version.findVersionNumber(ua, "opios/") || u.Browser.Version.findVersionNumber(ua, "opera/")
@@ -216,10 +517,230 @@ func (u *UserAgent) evalBrowserVersion(ua string) {
 	case BrowserSilk:
 		_ = u.Browser.Version.findVersionNumber(ua, "silk/")
 
+	case BrowserOperaMini:
+		_ = u.Browser.Version.findVersionNumber(ua, "opera mini/") || u.Browser.Version.findVersionNumber(ua, "opim/")
+
+	case BrowserPuffin:
+		_ = u.Browser.Version.findVersionNumber(ua, "puffin/")
+
+	case BrowserUCMini:
+		_ = u.Browser.Version.findVersionNumber(ua, "ucmini/")
+
 	case BrowserSpotify:
 		_ = u.Browser.Version.findVersionNumber(ua, "spotify/")
 
 	case BrowserCocCoc:
 		_ = u.Browser.Version.findVersionNumber(ua, "coc_coc_browser/")
+
+	case BrowserVivaldi:
+		_ = u.Browser.Version.findVersionNumber(ua, "vivaldi/")
+
+	case BrowserWhale:
+		_ = u.Browser.Version.findVersionNumber(ua, "whale/")
+
+	case BrowserOperaGX:
+		_ = u.Browser.Version.findVersionNumber(ua, "opx/") || u.Browser.Version.findVersionNumber(ua, "oprgx/") || u.Browser.Version.findVersionNumber(ua, "opr/")
+
+	case BrowserBrave:
+		// Brave tracks the Chromium major version
+		_ = u.Browser.Version.findVersionNumber(ua, "brave/") || u.Browser.Version.findVersionNumber(ua, "chrome/") || u.Browser.Version.findVersionNumber(ua, "crios/")
+
+	case BrowserArc:
+		_ = u.Browser.Version.findVersionNumber(ua, " arc/")
+
+	case BrowserDuckDuckGo:
+		_ = u.Browser.Version.findVersionNumber(ua, "duckduckgo/") || u.Browser.Version.findVersionNumber(ua, " ddg/")
+
+	case BrowserEcosia:
+		// e.g. (Ecosia android@120.0.6099.230)
+		_ = u.Browser.Version.findVersionNumber(ua, "ecosia android@") || u.Browser.Version.findVersionNumber(ua, "ecosia ios@") || u.Browser.Version.findVersionNumber(ua, "ecosia/")
+
+	case BrowserMaxthon:
+		_ = u.Browser.Version.findVersionNumber(ua, "maxthon/") || u.Browser.Version.findVersionNumber(ua, "maxthon ") || u.Browser.Version.findVersionNumber(ua, "mxbrowser/")
+
+	case BrowserSogouExplorer:
+		// desktop builds report their major version only, e.g. "SE 2.X MetaSr 1.0"
+		_ = u.Browser.Version.findVersionNumber(ua, "sogoumobilebrowser/") || u.Browser.Version.findVersionNumber(ua, "se ")
+
+	case BrowserNokia:
+		_ = u.Browser.Version.findVersionNumber(ua, "nokiabrowser/") || u.Browser.Version.findVersionNumber(ua, "browserng/") || u.Browser.Version.findVersionNumber(ua, "s40ovibrowser/")
+
+	case BrowserNetFront:
+		_ = u.Browser.Version.findVersionNumber(ua, "netfront/")
+
+	case BrowserHuawei:
+		_ = u.Browser.Version.findVersionNumber(ua, "huaweibrowser/")
+
+	case BrowserArkWeb:
+		_ = u.Browser.Version.findVersionNumber(ua, "arkweb/")
+
+	case BrowserAppleCoreMedia:
+		// the version is followed by the OS build, e.g. 1.0.0.21A329, which
+		// would read as build 21 with a pre-release tag
+		if v := &u.Browser.Version; v.findVersionNumber(ua, "applecoremedia/") && v.Build != 0 && v.Pre != "" {
+			*v = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Raw: v.Raw[:strings.LastIndexByte(v.Raw, '.')]}
+		}
+
+	case BrowserExoPlayer:
+		_ = u.Browser.Version.findVersionNumber(ua, "exoplayerlib/") || u.Browser.Version.findVersionNumber(ua, "androidxmedia3/")
+
+	case BrowserStagefright:
+		_ = u.Browser.Version.findVersionNumber(ua, "stagefright/")
+
+	case BrowserVLC:
+		_ = u.Browser.Version.findVersionNumber(ua, "vlc/")
+
+	case BrowserFFmpeg:
+		// older builds omit the slash, e.g. Lavf53.32.100
+		_ = u.Browser.Version.findVersionNumber(ua, "lavf/") || u.Browser.Version.findVersionNumber(ua, "lavf")
+
+	case BrowserRoku:
+		_ = u.Browser.Version.findVersionNumber(ua, "/dvp-")
+
+	case BrowserOvercast:
+		_ = u.Browser.Version.findVersionNumber(ua, "overcast/")
+
+	case BrowserPocketCasts:
+		_ = u.Browser.Version.findVersionNumber(ua, "pocketcasts/")
+
+	case BrowseriTunes:
+		_ = u.Browser.Version.findVersionNumber(ua, "itunes/")
+
+	case BrowserOutlook:
+		_ = u.Browser.Version.findVersionNumber(ua, "microsoft outlook ") || u.Browser.Version.findVersionNumber(ua, "microsoft outlook mail ")
+
+	case BrowserThunderbird:
+		_ = u.Browser.Version.findVersionNumber(ua, "thunderbird/")
+
+	case BrowserAppleMail:
+		_ = u.Browser.Version.findVersionNumber(ua, " mail/")
+	}
+}
+
+// stockWebKitMarkers are the tokens without a version that the WebKit
+// switch of evalBrowserName looks for ahead of Chrome and Safari.
+var stockWebKitMarkers = []string{"googlebot", "applebot", "edition gx", "msie ", "maxthon", "metasr", "360se", "360ee", "lbbrowser", " brave ", "ecosia"}
+
+// stockWebKit reports whether ua names no products other than those stock
+// Chrome and Safari send, such as chrome/120.0.0.0 and version/17.2, and none
+// of stockWebKitMarkers. Every other browser evalBrowserName tells apart from
+// Chrome and Safari on WebKit is named by a product token or a marker, so the
+// checks for them can be skipped.
+func stockWebKit(ua string) bool {
+	for i := strings.IndexByte(ua, '/'); i >= 0; {
+		start := i
+		for start > 0 && ua[start-1] != ' ' && ua[start-1] != '(' && ua[start-1] != ';' {
+			start--
+		}
+		switch ua[start:i] {
+		case "mozilla", "applewebkit", "chrome", "safari", "version", "mobile", "build":
+		default:
+			return false
+		}
+		next := strings.IndexByte(ua[i+1:], '/')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	for _, m := range stockWebKitMarkers {
+		if strings.Contains(ua, m) {
+			return false
+		}
+	}
+	return true
+}
+
+// webViewEngine returns the engine of a browser built on the system web view,
+// which is Chromium where the UA string names Chrome and WebKit otherwise.
+func webViewEngine(ua string) BrowserFamily {
+	if strings.Contains(ua, "chrome/") {
+		return FamilyChromium
+	}
+	return FamilyWebKit
+}
+
+// IsEdge reports whether the browser is Microsoft Edge. Edge is reported as
+// BrowserIE, and told apart from Internet Explorer by its version, from 12
+// onwards.
+func (b Browser) IsEdge() bool {
+	return b.Name == BrowserIE && b.Version.Major >= 12
+}
+
+// Family returns the family of the browser, based on the layout engine it is
+// built on. Every browser on iOS is reported as FamilyWebKit, as Apple requires
+// them to use it.
+func (ua *UserAgent) Family() BrowserFamily {
+	if ua.IsBot() {
+		return FamilyUnknown
+	}
+	if ua.OS.Name == OSiOS {
+		switch ua.Browser.Name {
+		case BrowserUnknown, BrowserAppleCoreMedia, BrowserOvercast, BrowserPocketCasts, BrowserSpotify:
+			return FamilyUnknown
+		}
+		return FamilyWebKit
+	}
+	if ua.Browser.Engine != FamilyUnknown {
+		return ua.Browser.Engine
+	}
+
+	switch ua.Browser.Name {
+	case BrowserChrome, BrowserVivaldi, BrowserWhale, BrowserOperaGX, BrowserBrave, BrowserArc, BrowserEcosia,
+		BrowserSamsung, BrowserYandex, BrowserCocCoc, BrowserSilk, BrowserHuawei, BrowserArkWeb,
+		BrowserQuark, BrowserMIUI, BrowserVivo, BrowserHeyTap, BrowserPuffin:
+		return FamilyChromium
+
+	case BrowserSafari, BrowserAndroid, BrowserAppleMail:
+		return FamilyWebKit
+
+	case BrowserFirefox, BrowserIceweasel, BrowserSeaMonkey, BrowserIceCat, BrowserWaterfox, BrowserLibreWolf,
+		BrowserPaleMoon, BrowserBasilisk, BrowserKMeleon, BrowserFirefoxFocus, BrowserThunderbird:
+		return FamilyGecko
+
+	case BrowserIE:
+		// Edge replaced Trident with EdgeHTML in version 12, and moved to
+		// Chromium with version 79
+		switch {
+		case ua.Browser.Version.Major >= 79:
+			return FamilyChromium
+		case ua.Browser.IsEdge():
+			return FamilyEdgeHTML
+		}
+		return FamilyTrident
+
+	// without an Engine, as when decoded from an older encoding
+	case BrowserMaxthon, BrowserQQ, BrowserSogouExplorer:
+		return FamilyChromium
+
+	case BrowserUCBrowser:
+		// the U3 and U4 engines are built on WebKit and Chromium, while U2
+		// pages are rendered on UC's servers
+		if ua.Browser.ServerRendered {
+			return FamilyUnknown
+		}
+		return FamilyChromium
+
+	case BrowserOpera:
+		// Opera moved to Chromium with version 15
+		if ua.Browser.Version.Major >= 15 {
+			return FamilyChromium
+		}
+		return FamilyPresto
+
+	case BrowserOperaMini:
+		// Presto on Opera's servers, a Chromium web view otherwise
+		if ua.Browser.ServerRendered {
+			return FamilyPresto
+		}
+		return FamilyChromium
+
+	case BrowserDuckDuckGo:
+		// WebKit on Apple platforms and a system web view elsewhere
+		if ua.OS.Platform == PlatformMac {
+			return FamilyWebKit
+		}
+		return FamilyChromium
 	}
+	return FamilyUnknown
 }
@@ -0,0 +1,159 @@
+package uasurfer
+
+import (
+	"strings"
+	"time"
+)
+
+// release anchors the release calendar of a browser: major was promoted to
+// the stable channel on date, and a new major follows every cadence on
+// average.
+type release struct {
+	major   int
+	date    time.Time
+	cadence time.Duration
+	// channels are the pre-release channels, ordered by how far ahead of
+	// stable their major version is. The last one covers any later majors.
+	channels []Channel
+}
+
+const year = 8766 * time.Hour // 365.25 days
+
+// releaseCalendar holds the release calendars bundled with this package. See
+// https://chromiumdash.appspot.com/schedule, https://whattrainisitnow.com/calendar/
+// and https://developer.apple.com/safari/technology-preview/release-notes/
+//
+// Past the anchors the calendars are extrapolated: Chrome and Edge ship 12
+// majors a year, a four-week cadence that skips a release over the holidays,
+// Firefox ships 13 and Safari one. The extrapolated dates stay within about
+// two weeks of the real schedules as long as the vendors keep their cadence,
+// so estimates for majors released in the last two weeks may be off by one.
+// A slipped schedule makes the error grow, so the anchors should be refreshed
+// from time to time; TestReleaseCalendarFresh fails once they are two years
+// old.
+var releaseCalendar = map[BrowserName]release{
+	BrowserChrome:  {141, time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC), year / 12, []Channel{ChannelBeta, ChannelDev, ChannelCanary}},
+	BrowserIE:      {141, time.Date(2025, 10, 3, 0, 0, 0, 0, time.UTC), year / 12, []Channel{ChannelBeta, ChannelDev, ChannelCanary}}, // Edge 79 onwards
+	BrowserFirefox: {144, time.Date(2025, 10, 14, 0, 0, 0, 0, time.UTC), year / 13, []Channel{ChannelBeta, ChannelNightly}},
+	BrowserSafari:  {26, time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC), year, []Channel{ChannelTechnologyPreview}},
+}
+
+// firefoxESRMajors lists the Firefox majors with an Extended Support Release.
+var firefoxESRMajors = []int{115, 128, 140}
+
+// stableMajor returns the major version on the stable channel at t, and false
+// if t is before the calendar's anchor.
+func (r release) stableMajor(t time.Time) (int, bool) {
+	d := t.Sub(r.date)
+	if d < 0 {
+		return 0, false
+	}
+	return r.major + int(d/r.cadence), true
+}
+
+// evalChannel sets the release channel from tokens in the UA string, or from
+// the build of a full Chrome or Edge version. Browsers that send neither are
+// left on ChannelUnknown.
+func (u *UserAgent) evalChannel(ua string) {
+	u.Browser.Channel = ChannelUnknown
+
+	switch u.Browser.Name {
+	case BrowserFirefox:
+		// pre-releases carry a suffix, e.g. firefox/122.0a1 or firefox/121.0b3
+		switch {
+		case strings.HasPrefix(u.Browser.Version.Pre, "a"):
+			u.Browser.Channel = ChannelNightly
+		case strings.HasPrefix(u.Browser.Version.Pre, "b"):
+			u.Browser.Channel = ChannelBeta
+		}
+
+	case BrowserChrome, BrowserIE:
+		// Chromium builds cut from trunk end in .0, e.g. 143.0.7474.0. They
+		// ship to Canary, and to Dev before a branch point, so both read as
+		// Canary. Builds from a release branch count up from there, e.g.
+		// 142.0.7444.34, and the reduced version in the UA string, 142.0.0.0,
+		// tells nothing. Edge before 79 isn't Chromium.
+		v := u.Browser.Version
+		if u.Browser.Name == BrowserIE && v.Major < 79 {
+			break
+		}
+		if v.Patch != 0 && v.Build == 0 {
+			u.Browser.Channel = ChannelCanary
+		}
+
+	case BrowserOpera, BrowserOperaGX:
+		switch {
+		case strings.Contains(ua, "(edition beta)"):
+			u.Browser.Channel = ChannelBeta
+		case strings.Contains(ua, "(edition developer)"):
+			u.Browser.Channel = ChannelDev
+		}
+	}
+}
+
+// EstimateChannel returns Browser.Channel if it is known, and otherwise
+// estimates the release channel of Chrome, Edge, Firefox and Safari on macOS
+// by placing their major version on the bundled release calendar as it stood
+// at t. Majors ahead of the stable release at t are taken as pre-releases,
+// and Firefox majors older than the previous stable release with an Extended
+// Support Release as ESR.
+//
+// The estimate is a guess: a browser that lags behind looks like an older
+// stable release, and dates far past the bundled anchors rely on the vendors
+// keeping their cadence (see releaseCalendar). EstimateChannel returns
+// ChannelUnknown for other browsers, for majors older than the previous stable
+// release, and for dates before the calendar.
+func (ua *UserAgent) EstimateChannel(t time.Time) Channel {
+	if ua.Browser.Channel != ChannelUnknown {
+		return ua.Browser.Channel
+	}
+
+	switch ua.Browser.Name {
+	case BrowserIE:
+		if ua.Browser.Version.Major < 79 {
+			return ChannelUnknown
+		}
+
+	// only Safari on macOS has a Technology Preview, and the version of
+	// Safari on iOS is inferred from the OS
+	case BrowserSafari:
+		if ua.OS.Platform != PlatformMac {
+			return ChannelUnknown
+		}
+	}
+
+	r, ok := releaseCalendar[ua.Browser.Name]
+	if !ok || ua.Browser.Version.Major == 0 {
+		return ChannelUnknown
+	}
+	stable, ok := r.stableMajor(t)
+	if !ok {
+		return ChannelUnknown
+	}
+
+	major := ua.Browser.Version.Major
+	switch {
+	case major > stable:
+		ahead := major - stable - 1
+		if ahead >= len(r.channels) {
+			ahead = len(r.channels) - 1
+		}
+		return r.channels[ahead]
+
+	case major >= stable-1:
+		return ChannelStable
+
+	case ua.Browser.Name == BrowserFirefox && isFirefoxESR(major):
+		return ChannelESR
+	}
+	return ChannelUnknown
+}
+
+func isFirefoxESR(major int) bool {
+	for _, m := range firefoxESRMajors {
+		if m == major {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package uasurfer
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestChannel(t *testing.T) {
+	testCases := []struct {
+		ua      string
+		channel Channel
+	}{
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:122.0) Gecko/20100101 Firefox/122.0a1", ChannelNightly},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0b3", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0 (Edition beta)", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0 (Edition developer)", ChannelDev},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/105.0.0.0", ChannelUnknown},
+
+		// Parse doesn't guess from the version
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0", ChannelUnknown},
+
+		// Chromium builds from trunk
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/143.0.7474.0 Safari/537.36", ChannelCanary},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/143.0.7474.0 Safari/537.36 Edg/143.0.3605.0", ChannelCanary},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.7444.34 Safari/537.36", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.7444.34 Safari/537.36 Edg/142.0.3595.20", ChannelUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			ua := Parse(tc.ua)
+			if ua.Browser.Channel != tc.channel {
+				t.Errorf("channel: got %v, wanted %v", ua.Browser.Channel, tc.channel)
+				t.Logf("agent: %s", tc.ua)
+			}
+		})
+	}
+}
+
+func TestEstimateChannel(t *testing.T) {
+	at := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		ua      string
+		channel Channel
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/143.0.0.0 Safari/537.36", ChannelDev},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/145.0.0.0 Safari/537.36", ChannelCanary},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36 Edg/142.0.0.0", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:144.0) Gecko/20100101 Firefox/144.0", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:145.0) Gecko/20100101 Firefox/145.0", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:146.0) Gecko/20100101 Firefox/146.0", ChannelNightly},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0b3", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0", ChannelESR},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:143.0) Gecko/20100101 Firefox/143.0", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:130.0) Gecko/20100101 Firefox/130.0", ChannelUnknown},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/26.0 Safari/605.1.15", ChannelStable},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/27.0 Safari/605.1.15", ChannelTechnologyPreview},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/27.0 Mobile/15E148 Safari/604.1", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/105.0.0.0", ChannelUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			ua := Parse(tc.ua)
+			if got := ua.EstimateChannel(at); got != tc.channel {
+				t.Errorf("channel: got %v, wanted %v", got, tc.channel)
+				t.Logf("agent: %s", tc.ua)
+			}
+		})
+	}
+
+	// before the calendar
+	ua := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36")
+	at = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	if got := ua.EstimateChannel(at); got != ChannelUnknown {
+		t.Errorf("channel at %v: got %v, wanted %v", at, got, ChannelUnknown)
+	}
+}
+
+// TestEstimateChannelExtrapolated checks the calendar past its anchors, a
+// year on: Chrome 153, Edge 153, Firefox 157 and Safari 27 are stable.
+func TestEstimateChannelExtrapolated(t *testing.T) {
+	at := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		ua      string
+		channel Channel
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/153.0.0.0 Safari/537.36", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/154.0.0.0 Safari/537.36", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/156.0.0.0 Safari/537.36", ChannelCanary},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36", ChannelUnknown},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/154.0.0.0 Safari/537.36 Edg/154.0.0.0", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:157.0) Gecko/20100101 Firefox/157.0", ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:158.0) Gecko/20100101 Firefox/158.0", ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:140.0) Gecko/20100101 Firefox/140.0", ChannelESR},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/27.0 Safari/605.1.15", ChannelStable},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/28.0 Safari/605.1.15", ChannelTechnologyPreview},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			ua := Parse(tc.ua)
+			if got := ua.EstimateChannel(at); got != tc.channel {
+				t.Errorf("channel: got %v, wanted %v", got, tc.channel)
+				t.Logf("agent: %s", tc.ua)
+			}
+		})
+	}
+}
+
+// TestReleaseCalendarFresh fails once the anchors of the release calendar are
+// old enough for a slipped schedule to throw the estimates off.
+func TestReleaseCalendarFresh(t *testing.T) {
+	for name, r := range releaseCalendar {
+		if age := time.Since(r.date); age > 2*year {
+			t.Errorf("%v: release calendar anchored %v ago, refresh it", name, age.Round(24*time.Hour))
+		}
+	}
+}
+
+func TestChannelFullVersionList(t *testing.T) {
+	at := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	h.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36")
+	h.Set("Sec-CH-UA", `"Chromium";v="142", "Brave";v="142", "Not_A Brand";v="99"`)
+	h.Set("Sec-CH-UA-Full-Version-List", `"Chromium";v="142.0.7444.34", "Brave";v="142.1.84.100", "Not_A Brand";v="99.0.0.0"`)
+
+	ua := ParseHeader(h)
+	if ua.Browser.Name != BrowserBrave {
+		t.Errorf("browserName: got %v, wanted %v", ua.Browser.Name, BrowserBrave)
+	}
+	if want := (Version{Major: 142, Minor: 1, Patch: 84, Build: 100, Raw: "142.1.84.100"}); ua.Browser.Version != want {
+		t.Errorf("browser version: got %v, wanted %v", ua.Browser.Version, want)
+	}
+	// Brave is not on the release calendar
+	if got := ua.EstimateChannel(at); got != ChannelUnknown {
+		t.Errorf("channel: got %v, wanted %v", got, ChannelUnknown)
+	}
+
+	h.Set("Sec-CH-UA", `"Chromium";v="142", "Google Chrome";v="142", "Not_A Brand";v="99"`)
+	h.Set("Sec-CH-UA-Full-Version-List", `"Chromium";v="142.0.7444.34", "Google Chrome";v="142.0.7444.34", "Not_A Brand";v="99.0.0.0"`)
+
+	ua = ParseHeader(h)
+	if want := (Version{Major: 142, Minor: 0, Patch: 7444, Build: 34, Raw: "142.0.7444.34"}); ua.Browser.Version != want {
+		t.Errorf("browser version: got %v, wanted %v", ua.Browser.Version, want)
+	}
+	if ua.Browser.Channel != ChannelUnknown {
+		t.Errorf("channel: got %v, wanted %v", ua.Browser.Channel, ChannelUnknown)
+	}
+	if got := ua.EstimateChannel(at); got != ChannelBeta {
+		t.Errorf("channel: got %v, wanted %v", got, ChannelBeta)
+	}
+
+	// Edge Canary, from trunk
+	h.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/143.0.0.0 Safari/537.36 Edg/143.0.0.0")
+	h.Set("Sec-CH-UA", `"Chromium";v="143", "Microsoft Edge";v="143", "Not_A Brand";v="99"`)
+	h.Set("Sec-CH-UA-Full-Version-List", `"Chromium";v="143.0.7474.0", "Microsoft Edge";v="143.0.3605.0", "Not_A Brand";v="99.0.0.0"`)
+
+	ua = ParseHeader(h)
+	if !ua.Browser.IsEdge() {
+		t.Errorf("browserName: got %v, wanted Edge", ua.Browser.Name)
+	}
+	if ua.Browser.Channel != ChannelCanary {
+		t.Errorf("channel: got %v, wanted %v", ua.Browser.Channel, ChannelCanary)
+	}
+}
@@ -0,0 +1,136 @@
+package uasurfer
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/avct/uasurfer/internal/clienthints"
+)
+
+// ParseHeader accepts the headers of an HTTP request and returns the UserAgent.
+// The User-Agent header is parsed as with Parse, then refined with User-Agent
+// Client Hints where the browser sends them, for example to tell Brave apart
+// from Chrome.
+//
+// Proxy browsers and some carriers forward the handset's own user agent in a
+// separate header (see deviceHeaders). When one is present the OS and device
+// type are taken from it, while the browser still comes from User-Agent.
+func ParseHeader(h http.Header) *UserAgent {
+	dest := new(UserAgent)
+	parseHeader(h, dest)
+	return dest
+}
+
+// ParseUserAgentHeader is the same as ParseHeader, but populates the supplied
+// UserAgent. It is the caller's responsibility to call Reset() on the UserAgent
+// before passing it to this function.
+func ParseUserAgentHeader(h http.Header, dest *UserAgent) {
+	parseHeader(h, dest)
+}
+
+func parseHeader(h http.Header, dest *UserAgent) {
+	ua := h.Get("User-Agent")
+	parse(ua, dest)
+	chua := h.Get("Sec-CH-UA")
+	fullVersionList := h.Get("Sec-CH-UA-Full-Version-List")
+	if chua != "" || fullVersionList != "" {
+		if chua != "" {
+			dest.evalBrands(chua)
+		}
+		if fullVersionList != "" {
+			dest.evalFullVersionList(fullVersionList)
+		}
+		// the brands may change the browser or its version
+		if !dest.IsBot() {
+			dest.evalChannel(normalise(ua))
+		}
+	}
+	dest.evalDeviceHeaders(h)
+}
+
+// deviceHeaders lists the headers carrying the user agent of the handset
+// behind a proxy browser or carrier gateway, in order of preference.
+var deviceHeaders = []string{
+	"X-OperaMini-Phone-UA",
+	"Device-Stock-UA",
+	"X-Device-User-Agent",
+	"X-Original-User-Agent",
+}
+
+// evalDeviceHeaders replaces the OS and device type with those of the first
+// device header that identifies either. Bots keep OSBot and PlatformBot,
+// whatever device headers they send.
+func (u *UserAgent) evalDeviceHeaders(h http.Header) {
+	if u.IsBot() {
+		return
+	}
+	for _, name := range deviceHeaders {
+		v := h.Get(name)
+		if v == "" {
+			continue
+		}
+		var device UserAgent
+		parse(v, &device)
+		if device.OS.Name == OSUnknown && device.DeviceType == DeviceUnknown {
+			continue
+		}
+		u.OS = device.OS
+		u.DeviceType = device.DeviceType
+		return
+	}
+}
+
+// clientHintBrands maps the brands browsers send in Sec-CH-UA to a
+// BrowserName, for browsers whose User-Agent reads as plain Chrome.
+var clientHintBrands = map[string]BrowserName{
+	"brave":          BrowserBrave,
+	"microsoft edge": BrowserIE,
+	"opera":          BrowserOpera,
+	"opera gx":       BrowserOperaGX,
+	"whale":          BrowserWhale,
+	"yandex":         BrowserYandex,
+	"duckduckgo":     BrowserDuckDuckGo,
+}
+
+// evalBrands refines a Chrome UserAgent using the brand list of a
+// Sec-CH-UA header, e.g. "Chromium";v="120", "Brave";v="120", "Not?A_Brand";v="24".
+// Opera GX sends the same User-Agent as Opera, so Opera is refined to Opera
+// GX as well.
+func (u *UserAgent) evalBrands(chua string) {
+	if u.Browser.Name != BrowserChrome && u.Browser.Name != BrowserOpera {
+		return
+	}
+	for _, b := range clienthints.ParseBrands(chua) {
+		name, ok := clientHintBrands[strings.ToLower(b.Brand)]
+		if !ok || u.Browser.Name == BrowserOpera && name != BrowserOperaGX {
+			continue
+		}
+		u.Browser.Name = name
+		var v Version
+		if v.parse(b.Version) {
+			u.Browser.Version = v
+		}
+		return
+	}
+}
+
+// evalFullVersionList replaces the browser version, which Chromium reduces to
+// the major version in the User-Agent, with the full version from a
+// Sec-CH-UA-Full-Version-List header.
+func (u *UserAgent) evalFullVersionList(list string) {
+	for _, b := range clienthints.ParseBrands(list) {
+		name := strings.ToLower(b.Brand)
+		n, ok := clientHintBrands[name]
+		if name == "google chrome" {
+			n, ok = BrowserChrome, true
+		}
+		if !ok || n != u.Browser.Name {
+			continue
+		}
+		var v Version
+		if v.parse(b.Version) {
+			u.Browser.Version = v
+		}
+		return
+	}
+}
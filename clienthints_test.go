@@ -0,0 +1,99 @@
+package uasurfer
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseHeader(t *testing.T) {
+	const chromeWin = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	const operaWin = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0"
+
+	testCases := []struct {
+		ua      string
+		chua    string
+		name    BrowserName
+		version Version
+	}{
+		{chromeWin, "", BrowserChrome, Version{Major: 120, Minor: 0, Patch: 0}},
+		{chromeWin, `"Not_A Brand";v="8", "Chromium";v="120", "Google Chrome";v="120"`, BrowserChrome, Version{Major: 120, Minor: 0, Patch: 0}},
+		{chromeWin, `"Not_A Brand";v="8", "Chromium";v="120", "Brave";v="120"`, BrowserBrave, Version{Major: 120, Minor: 0, Patch: 0}},
+		{chromeWin, `"Opera GX";v="105", "Chromium";v="119", "Not?A_Brand";v="24"`, BrowserOperaGX, Version{Major: 105, Minor: 0, Patch: 0}},
+		{operaWin, `"Opera GX";v="106", "Chromium";v="120", "Not?A_Brand";v="24"`, BrowserOperaGX, Version{Major: 106, Minor: 0, Patch: 0}},
+		{operaWin, `"Opera";v="106", "Chromium";v="120", "Not?A_Brand";v="24"`, BrowserOpera, Version{Major: 106, Minor: 0, Patch: 0}},
+		{operaWin, `"Brave";v="120"`, BrowserOpera, Version{Major: 106, Minor: 0, Patch: 0}},
+		{chromeWin, `malformed, "Brave";v=`, BrowserBrave, Version{Major: 120, Minor: 0, Patch: 0}},
+		// brands never override a browser identified from the User-Agent
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Vivaldi/6.5.3206.48", `"Brave";v="120"`, BrowserVivaldi, Version{Major: 6, Minor: 5, Patch: 3206}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			h := http.Header{}
+			h.Set("User-Agent", tc.ua)
+			if tc.chua != "" {
+				h.Set("Sec-CH-UA", tc.chua)
+			}
+
+			ua := ParseHeader(h)
+			if ua.Browser.Name != tc.name {
+				t.Errorf("browserName: got %v, wanted %v", ua.Browser.Name, tc.name)
+			}
+			if numbers(ua.Browser.Version) != tc.version {
+				t.Errorf("browser version: got %v, wanted %v", ua.Browser.Version, tc.version)
+			}
+			if ua.OS.Name != OSWindows {
+				t.Errorf("os: got %v, wanted %v", ua.OS.Name, OSWindows)
+			}
+		})
+	}
+}
+
+func TestParseHeaderDeviceUA(t *testing.T) {
+	const operaMini = "Opera/9.80 (Android; Opera Mini/36.2.2254/119.132; U; id) Presto/2.12.423 Version/12.16"
+	const galaxy = "Mozilla/5.0 (Linux; Android 10; SM-A105F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/89.0.4389.105 Mobile Safari/537.36"
+
+	testCases := []struct {
+		headers  map[string]string
+		expected UserAgent
+	}{
+		{
+			map[string]string{"User-Agent": operaMini, "X-OperaMini-Phone-UA": galaxy},
+			UserAgent{Browser{Name: BrowserOperaMini, Version: Version{Major: 36, Minor: 2, Patch: 2254}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone},
+		},
+		{
+			map[string]string{"User-Agent": operaMini, "Device-Stock-UA": "Nokia6300/2.0 (05.00) Profile/MIDP-2.0 Configuration/CLDC-1.1"},
+			UserAgent{Browser{Name: BrowserOperaMini, Version: Version{Major: 36, Minor: 2, Patch: 2254}, ServerRendered: true}, OS{PlatformUnknown, OSSeries40, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone},
+		},
+		// headers the device can't be recognised from are skipped
+		{
+			map[string]string{"User-Agent": operaMini, "X-Device-User-Agent": "unknown", "X-Original-User-Agent": "Mozilla/5.0 (iPhone; CPU iPhone OS 12_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148"},
+			UserAgent{Browser{Name: BrowserOperaMini, Version: Version{Major: 36, Minor: 2, Patch: 2254}, ServerRendered: true}, OS{PlatformiPhone, OSiOS, Version{Major: 12, Minor: 4, Patch: 0}}, DevicePhone},
+		},
+		// bots keep their own OS and device type
+		{
+			map[string]string{"User-Agent": "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", "X-Device-User-Agent": galaxy},
+			UserAgent{Browser{Name: BrowserGoogleBot}, OS{PlatformBot, OSBot, Version{}}, DeviceComputer},
+		},
+		{
+			map[string]string{"User-Agent": operaMini},
+			UserAgent{Browser{Name: BrowserOperaMini, Version: Version{Major: 36, Minor: 2, Patch: 2254}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tc.headers {
+				h.Set(k, v)
+			}
+
+			ua := ParseHeader(h)
+			ua.Browser.Version = numbers(ua.Browser.Version)
+			ua.OS.Version = numbers(ua.OS.Version)
+			if *ua != tc.expected {
+				t.Errorf("got %+v, wanted %+v", *ua, tc.expected)
+			}
+		})
+	}
+}
@@ -14,8 +14,12 @@ func (u *UserAgent) evalDevice(ua string) {
 		}
 		u.DeviceType = DeviceComputer
 
+	case u.OS.Name == OSKaiOS || u.OS.Name == OSSeries40 || u.OS.Name == OSJ2ME:
+		u.DeviceType = DeviceFeaturePhone
+
 	// long list of smarttv and tv dongle identifiers - above "phone" and "tablet" check to prevent TVs from being detected as phones/tablets
-	case strings.Contains(ua, "tv") || strings.Contains(ua, "crkey") || strings.Contains(ua, "googletv") || strings.Contains(ua, "aftb") || strings.Contains(ua, "aftt") || strings.Contains(ua, "aftm") ||
+	case u.OS.Name == OSWebOSTV || u.OS.Name == OSRokuOS || u.OS.Name == OStvOS || u.OS.Name == OSFireOS || u.OS.Name == OSAndroidTV || u.OS.Name == OSVIDAA ||
+		strings.Contains(ua, "tv") || strings.Contains(ua, "crkey") || strings.Contains(ua, "googletv") || strings.Contains(ua, "aftb") || strings.Contains(ua, "aftt") || strings.Contains(ua, "aftm") ||
 		strings.Contains(ua, "adt-") || strings.Contains(ua, "roku") || strings.Contains(ua, "viera") || strings.Contains(ua, "aquos") || strings.Contains(ua, "dtv") ||
 		strings.Contains(ua, "appletv") || strings.Contains(ua, "smarttv") || strings.Contains(ua, "tuner") || strings.Contains(ua, "smart-tv") || strings.Contains(ua, "hbbtv") ||
 		strings.Contains(ua, "netcast") || strings.Contains(ua, "vizio") || strings.Contains(ua, "stb") || strings.Contains(ua, "swisscom-ip") || strings.Contains(ua, "youview") ||
@@ -35,7 +39,7 @@ func (u *UserAgent) evalDevice(ua string) {
 	case u.OS.Platform == PlatformiPhone || u.OS.Platform == PlatformBlackberry || strings.Contains(ua, "phone"):
 		u.DeviceType = DevicePhone
 
-	case u.OS.Name == OSAndroid:
+	case u.OS.Name == OSAndroid || u.OS.Name == OSHarmonyOS:
 		// android phones report as "mobile", android tablets should not but often do -- http://android-developers.blogspot.com/2010/12/android-browser-user-agent-issues.html
 		if strings.Contains(ua, "mobile") {
 			u.DeviceType = DevicePhone
@@ -0,0 +1,83 @@
+package uasurfer
+
+import "strings"
+
+// HbbTV contains the structured fields smart TVs report in the HbbTV or
+// OIPF token of their user agent, as specified in ETSI TS 102 796:
+//
+//	HbbTV/1.5.1 (<capabilities>; <vendorName>; <modelName>; <softwareVersion>; <hardwareVersion>; <reserved>)
+//
+// CE-HTML tokens carry a version only. Text fields are returned as found,
+// without changing case, and are empty when the TV leaves them out.
+type HbbTV struct {
+	Standard        string // "HbbTV", "OIPF" or "CE-HTML"
+	Version         Version
+	Capabilities    []string // option strings without the leading "+", e.g. "DRM", "PVR"
+	Vendor          string
+	Model           string
+	SoftwareVersion string
+	HardwareVersion string
+}
+
+// ParseHbbTV extracts the HbbTV, OIPF or CE-HTML fields from a raw user
+// agent, in that order of preference. It returns false if the user agent
+// contains none of those tokens.
+func ParseHbbTV(ua string) (HbbTV, bool) {
+	var tv HbbTV
+	for _, standard := range []string{"HbbTV", "OIPF", "CE-HTML"} {
+		i := indexFold(ua, standard+"/")
+		if i == -1 {
+			continue
+		}
+		tv.Standard = standard
+		rest := ua[i+len(standard)+1:]
+		tv.Version.parse(rest)
+		if standard != "CE-HTML" {
+			tv.parseFields(rest)
+		}
+		return tv, true
+	}
+	return tv, false
+}
+
+// parseFields reads the parenthesised field list following the version.
+func (tv *HbbTV) parseFields(s string) {
+	s = strings.TrimLeft(s, "0123456789. ")
+	if len(s) == 0 || s[0] != '(' {
+		return
+	}
+	e := strings.IndexByte(s, ')')
+	if e == -1 {
+		e = len(s)
+	}
+
+	for i, f := range strings.Split(s[1:e], ";") {
+		f = strings.TrimSpace(f)
+		switch i {
+		case 0:
+			for _, c := range strings.Split(f, "+") {
+				if c != "" {
+					tv.Capabilities = append(tv.Capabilities, c)
+				}
+			}
+		case 1:
+			tv.Vendor = f
+		case 2:
+			tv.Model = f
+		case 3:
+			tv.SoftwareVersion = f
+		case 4:
+			tv.HardwareVersion = f
+		}
+	}
+}
+
+// indexFold is like strings.Index but ignores ASCII case.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,52 @@
+package uasurfer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseHbbTV(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected HbbTV
+		ok       bool
+	}{
+		{
+			"Mozilla/5.0 (SMART-TV; LINUX; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) 76.0.3809.146/6.0 TV Safari/537.36 HbbTV/1.5.1 (+DRM;Samsung;SmartTV2021;T-KSU2EDEUC-1520.6;;)",
+			HbbTV{Standard: "HbbTV", Version: Version{Major: 1, Minor: 5, Patch: 1, Raw: "1.5.1"}, Capabilities: []string{"DRM"}, Vendor: "Samsung", Model: "SmartTV2021", SoftwareVersion: "T-KSU2EDEUC-1520.6"},
+			true,
+		},
+		{
+			"Mozilla/5.0 (Linux ) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/108.0.5359.128 Safari/537.36 OPR/46.0.2207.0 OMI/4.23.2.96.LIMA2.71 Model/Vestel-MB180 VSTVB MB100 FVC/8.0 (OEM; MB180; ) HbbTV/1.6.1 (+DRM; OEM; MB180; 0.20.0.0; ; _TV_G31_2023;) TiVoOS/1.0.0 (Vestel MB180 OEM) SmartTvA/3.0.0",
+			HbbTV{Standard: "HbbTV", Version: Version{Major: 1, Minor: 6, Patch: 1, Raw: "1.6.1"}, Capabilities: []string{"DRM"}, Vendor: "OEM", Model: "MB180", SoftwareVersion: "0.20.0.0"},
+			true,
+		},
+		{
+			"Opera/9.80 (Linux mips; U; HbbTV/1.1.1 (+PVR+DL; Philips; 32PFL7605H/12; 1.2.3; 5.0; ); CE-HTML/1.0 NETTV/3.2.1; en) Presto/2.6.33 Version/10.70",
+			HbbTV{Standard: "HbbTV", Version: Version{Major: 1, Minor: 1, Patch: 1, Raw: "1.1.1"}, Capabilities: []string{"PVR", "DL"}, Vendor: "Philips", Model: "32PFL7605H/12", SoftwareVersion: "1.2.3", HardwareVersion: "5.0"},
+			true,
+		},
+		{
+			"Opera/9.80 (Linux sh4; U; CE-HTML/1.0 NETTV/2.0.3; en) Presto/2.2.1 Version/10.00",
+			HbbTV{Standard: "CE-HTML", Version: Version{Major: 1, Minor: 0, Patch: 0, Raw: "1.0"}},
+			true,
+		},
+		{
+			"Mozilla/5.0 (Linux; Tizen 2.3; SmartHub; SMART-TV; SmartTV; U; Maple2012) AppleWebKit/538.1+ (KHTML, like Gecko) TV Safari/538.1+",
+			HbbTV{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			tv, ok := ParseHbbTV(tc.ua)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok %v, got %v", tc.ok, ok)
+			}
+			if !reflect.DeepEqual(tv, tc.expected) {
+				t.Fatalf("Expected %+v, got %+v", tc.expected, tv)
+			}
+		})
+	}
+}
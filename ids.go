@@ -0,0 +1,271 @@
+package uasurfer
+
+// The IDs below never change and are never reused, for storing results as
+// numbers. New constants are declared at the end of their block and take the
+// next free ID, so that their value equals their ID, but only the IDs are
+// guaranteed to stay.
+
+// enumMeta holds the stable ID of a constant and, for browsers, the classes
+// it belongs to.
+type enumMeta struct {
+	id    int
+	flags metaFlag
+}
+
+type metaFlag uint8
+
+const (
+	flagBot metaFlag = 1 << iota
+	flagMediaPlayer
+	flagEmailClient // email clients and proxies
+	flagEmailProxy
+)
+
+// deviceTypeMeta is indexed by DeviceType.
+var deviceTypeMeta = [...]enumMeta{
+	DeviceUnknown:      {id: 0},
+	DeviceComputer:     {id: 1},
+	DeviceTablet:       {id: 2},
+	DevicePhone:        {id: 3},
+	DeviceConsole:      {id: 4},
+	DeviceWearable:     {id: 5},
+	DeviceTV:           {id: 6},
+	DeviceFeaturePhone: {id: 7},
+}
+
+// browserNameMeta is indexed by BrowserName.
+var browserNameMeta = [...]enumMeta{
+	BrowserUnknown:          {id: 0},
+	BrowserChrome:           {id: 1},
+	BrowserIE:               {id: 2},
+	BrowserSafari:           {id: 3},
+	BrowserFirefox:          {id: 4},
+	BrowserAndroid:          {id: 5},
+	BrowserOpera:            {id: 6},
+	BrowserBlackberry:       {id: 7},
+	BrowserUCBrowser:        {id: 8},
+	BrowserSilk:             {id: 9},
+	BrowserNokia:            {id: 10},
+	BrowserNetFront:         {id: 11},
+	BrowserQQ:               {id: 12},
+	BrowserMaxthon:          {id: 13},
+	BrowserSogouExplorer:    {id: 14},
+	BrowserSpotify:          {id: 15, flags: flagMediaPlayer},
+	BrowserNintendo:         {id: 16},
+	BrowserSamsung:          {id: 17},
+	BrowserYandex:           {id: 18},
+	BrowserCocCoc:           {id: 19},
+	BrowserBot:              {id: 20, flags: flagBot},
+	BrowserAppleBot:         {id: 21, flags: flagBot},
+	BrowserBaiduBot:         {id: 22, flags: flagBot},
+	BrowserBingBot:          {id: 23, flags: flagBot},
+	BrowserDuckDuckGoBot:    {id: 24, flags: flagBot},
+	BrowserFacebookBot:      {id: 25, flags: flagBot},
+	BrowserGoogleBot:        {id: 26, flags: flagBot},
+	BrowserLinkedInBot:      {id: 27, flags: flagBot},
+	BrowserMsnBot:           {id: 28, flags: flagBot},
+	BrowserPingdomBot:       {id: 29, flags: flagBot},
+	BrowserTwitterBot:       {id: 30, flags: flagBot},
+	BrowserYandexBot:        {id: 31, flags: flagBot},
+	BrowserCocCocBot:        {id: 32, flags: flagBot},
+	BrowserYahooBot:         {id: 33, flags: flagBot},
+	BrowserHuawei:           {id: 34},
+	BrowserArkWeb:           {id: 35},
+	BrowserVivaldi:          {id: 36},
+	BrowserWhale:            {id: 37},
+	BrowserOperaGX:          {id: 38},
+	BrowserBrave:            {id: 39},
+	BrowserArc:              {id: 40},
+	BrowserDuckDuckGo:       {id: 41},
+	BrowserEcosia:           {id: 42},
+	BrowserIceweasel:        {id: 43},
+	BrowserSeaMonkey:        {id: 44},
+	BrowserIceCat:           {id: 45},
+	BrowserWaterfox:         {id: 46},
+	BrowserLibreWolf:        {id: 47},
+	BrowserPaleMoon:         {id: 48},
+	BrowserBasilisk:         {id: 49},
+	BrowserKMeleon:          {id: 50},
+	BrowserFirefoxFocus:     {id: 51},
+	BrowserBaidu:            {id: 52},
+	Browser360:              {id: 53},
+	BrowserQuark:            {id: 54},
+	BrowserMIUI:             {id: 55},
+	BrowserVivo:             {id: 56},
+	BrowserHeyTap:           {id: 57},
+	BrowserLiebao:           {id: 58},
+	Browser2345:             {id: 59},
+	BrowserOperaMini:        {id: 60},
+	BrowserPuffin:           {id: 61},
+	BrowserUCMini:           {id: 62},
+	BrowserAppleCoreMedia:   {id: 63, flags: flagMediaPlayer},
+	BrowserExoPlayer:        {id: 64, flags: flagMediaPlayer},
+	BrowserStagefright:      {id: 65, flags: flagMediaPlayer},
+	BrowserVLC:              {id: 66, flags: flagMediaPlayer},
+	BrowserFFmpeg:           {id: 67, flags: flagMediaPlayer},
+	BrowserRoku:             {id: 68, flags: flagMediaPlayer},
+	BrowserOvercast:         {id: 69, flags: flagMediaPlayer},
+	BrowserPocketCasts:      {id: 70, flags: flagMediaPlayer},
+	BrowseriTunes:           {id: 71, flags: flagMediaPlayer},
+	BrowserOutlook:          {id: 72, flags: flagEmailClient},
+	BrowserThunderbird:      {id: 73, flags: flagEmailClient},
+	BrowserAppleMail:        {id: 74, flags: flagEmailClient},
+	BrowserGoogleImageProxy: {id: 75, flags: flagEmailClient | flagEmailProxy},
+	BrowserYahooMailProxy:   {id: 76, flags: flagEmailClient | flagEmailProxy},
+}
+
+// osNameMeta is indexed by OSName.
+var osNameMeta = [...]enumMeta{
+	OSUnknown:      {id: 0},
+	OSWindowsPhone: {id: 1},
+	OSWindows:      {id: 2},
+	OSMacOSX:       {id: 3},
+	OSiOS:          {id: 4},
+	OSAndroid:      {id: 5},
+	OSBlackberry:   {id: 6},
+	OSChromeOS:     {id: 7},
+	OSKindle:       {id: 8},
+	OSWebOS:        {id: 9},
+	OSLinux:        {id: 10},
+	OSPlaystation:  {id: 11},
+	OSXbox:         {id: 12},
+	OSNintendo:     {id: 13},
+	OSBot:          {id: 14},
+	OSTizen:        {id: 15},
+	OSWebOSTV:      {id: 16},
+	OSRokuOS:       {id: 17},
+	OStvOS:         {id: 18},
+	OSFireOS:       {id: 19},
+	OSAndroidTV:    {id: 20},
+	OSVIDAA:        {id: 21},
+	OSHarmonyOS:    {id: 22},
+	OSOpenHarmony:  {id: 23},
+	OSKaiOS:        {id: 24},
+	OSSeries40:     {id: 25},
+	OSJ2ME:         {id: 26},
+}
+
+// platformMeta is indexed by Platform.
+var platformMeta = [...]enumMeta{
+	PlatformUnknown:      {id: 0},
+	PlatformWindows:      {id: 1},
+	PlatformMac:          {id: 2},
+	PlatformLinux:        {id: 3},
+	PlatformiPad:         {id: 4},
+	PlatformiPhone:       {id: 5},
+	PlatformiPod:         {id: 6},
+	PlatformBlackberry:   {id: 7},
+	PlatformWindowsPhone: {id: 8},
+	PlatformPlaystation:  {id: 9},
+	PlatformXbox:         {id: 10},
+	PlatformNintendo:     {id: 11},
+	PlatformBot:          {id: 12},
+	PlatformAppleTV:      {id: 13},
+}
+
+var (
+	deviceTypeIDs  = idIndex[DeviceType](deviceTypeMeta[:])
+	browserNameIDs = idIndex[BrowserName](browserNameMeta[:])
+	osNameIDs      = idIndex[OSName](osNameMeta[:])
+	platformIDs    = idIndex[Platform](platformMeta[:])
+)
+
+// idIndex maps the IDs of a metadata table back to constants.
+func idIndex[T ~int](meta []enumMeta) map[int]T {
+	m := make(map[int]T, len(meta))
+	for i, e := range meta {
+		m[e.id] = T(i)
+	}
+	return m
+}
+
+// ID returns the stable ID of d, which doesn't change when constants are
+// added. Values out of range return the ID of DeviceUnknown.
+func (d DeviceType) ID() int {
+	if d < 0 || int(d) >= len(deviceTypeMeta) {
+		return deviceTypeMeta[DeviceUnknown].id
+	}
+	return deviceTypeMeta[d].id
+}
+
+// DeviceTypeByID returns the DeviceType with the stable ID id, and false if
+// there is none.
+func DeviceTypeByID(id int) (DeviceType, bool) {
+	d, ok := deviceTypeIDs[id]
+	return d, ok
+}
+
+// ID returns the stable ID of b, which doesn't change when constants are
+// added. Registered browsers have IDs in the order they were registered (see
+// RegisterBrowser). Values out of range return the ID of BrowserUnknown.
+func (b BrowserName) ID() int {
+	if b >= 0 && int(b) < len(browserNameMeta) {
+		return browserNameMeta[b].id
+	}
+	if _, ok := registered().browser(b); ok {
+		return registeredBrowserIDs + int(b) - len(browserNameMeta)
+	}
+	return browserNameMeta[BrowserUnknown].id
+}
+
+// BrowserNameByID returns the BrowserName with the stable ID id, and false
+// if there is none.
+func BrowserNameByID(id int) (BrowserName, bool) {
+	if id >= registeredBrowserIDs {
+		b := BrowserName(len(browserNameMeta) + id - registeredBrowserIDs)
+		_, ok := registered().browser(b)
+		return b, ok
+	}
+	b, ok := browserNameIDs[id]
+	return b, ok
+}
+
+// is reports whether b belongs to any of the classes in f.
+func (b BrowserName) is(f metaFlag) bool {
+	if b >= 0 && int(b) < len(browserNameMeta) {
+		return browserNameMeta[b].flags&f != 0
+	}
+	m, ok := registered().browser(b)
+	return ok && m.flags&f != 0
+}
+
+// ID returns the stable ID of o, which doesn't change when constants are
+// added. Registered OSes have IDs in the order they were registered (see
+// RegisterOS). Values out of range return the ID of OSUnknown.
+func (o OSName) ID() int {
+	if o >= 0 && int(o) < len(osNameMeta) {
+		return osNameMeta[o].id
+	}
+	if _, ok := registered().os(o); ok {
+		return registeredOSIDs + int(o) - len(osNameMeta)
+	}
+	return osNameMeta[OSUnknown].id
+}
+
+// OSNameByID returns the OSName with the stable ID id, and false if there is
+// none.
+func OSNameByID(id int) (OSName, bool) {
+	if id >= registeredOSIDs {
+		o := OSName(len(osNameMeta) + id - registeredOSIDs)
+		_, ok := registered().os(o)
+		return o, ok
+	}
+	o, ok := osNameIDs[id]
+	return o, ok
+}
+
+// ID returns the stable ID of p, which doesn't change when constants are
+// added. Values out of range return the ID of PlatformUnknown.
+func (p Platform) ID() int {
+	if p < 0 || int(p) >= len(platformMeta) {
+		return platformMeta[PlatformUnknown].id
+	}
+	return platformMeta[p].id
+}
+
+// PlatformByID returns the Platform with the stable ID id, and false if
+// there is none.
+func PlatformByID(id int) (Platform, bool) {
+	p, ok := platformIDs[id]
+	return p, ok
+}
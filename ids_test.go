@@ -0,0 +1,214 @@
+package uasurfer
+
+import (
+	"strings"
+	"testing"
+)
+
+// The IDs below are persisted by users of the package and must never change.
+// New constants are added at the end of their block with the next free ID, and
+// the IDs of removed constants are never reused.
+
+var deviceTypeIDsV1 = map[string]int{
+	"DeviceUnknown":      0,
+	"DeviceComputer":     1,
+	"DeviceTablet":       2,
+	"DevicePhone":        3,
+	"DeviceConsole":      4,
+	"DeviceWearable":     5,
+	"DeviceTV":           6,
+	"DeviceFeaturePhone": 7,
+}
+
+var browserNameIDsV1 = map[string]int{
+	"BrowserUnknown":          0,
+	"BrowserChrome":           1,
+	"BrowserIE":               2,
+	"BrowserSafari":           3,
+	"BrowserFirefox":          4,
+	"BrowserAndroid":          5,
+	"BrowserOpera":            6,
+	"BrowserBlackberry":       7,
+	"BrowserUCBrowser":        8,
+	"BrowserSilk":             9,
+	"BrowserNokia":            10,
+	"BrowserNetFront":         11,
+	"BrowserQQ":               12,
+	"BrowserMaxthon":          13,
+	"BrowserSogouExplorer":    14,
+	"BrowserSpotify":          15,
+	"BrowserNintendo":         16,
+	"BrowserSamsung":          17,
+	"BrowserYandex":           18,
+	"BrowserCocCoc":           19,
+	"BrowserBot":              20,
+	"BrowserAppleBot":         21,
+	"BrowserBaiduBot":         22,
+	"BrowserBingBot":          23,
+	"BrowserDuckDuckGoBot":    24,
+	"BrowserFacebookBot":      25,
+	"BrowserGoogleBot":        26,
+	"BrowserLinkedInBot":      27,
+	"BrowserMsnBot":           28,
+	"BrowserPingdomBot":       29,
+	"BrowserTwitterBot":       30,
+	"BrowserYandexBot":        31,
+	"BrowserCocCocBot":        32,
+	"BrowserYahooBot":         33,
+	"BrowserHuawei":           34,
+	"BrowserArkWeb":           35,
+	"BrowserVivaldi":          36,
+	"BrowserWhale":            37,
+	"BrowserOperaGX":          38,
+	"BrowserBrave":            39,
+	"BrowserArc":              40,
+	"BrowserDuckDuckGo":       41,
+	"BrowserEcosia":           42,
+	"BrowserIceweasel":        43,
+	"BrowserSeaMonkey":        44,
+	"BrowserIceCat":           45,
+	"BrowserWaterfox":         46,
+	"BrowserLibreWolf":        47,
+	"BrowserPaleMoon":         48,
+	"BrowserBasilisk":         49,
+	"BrowserKMeleon":          50,
+	"BrowserFirefoxFocus":     51,
+	"BrowserBaidu":            52,
+	"Browser360":              53,
+	"BrowserQuark":            54,
+	"BrowserMIUI":             55,
+	"BrowserVivo":             56,
+	"BrowserHeyTap":           57,
+	"BrowserLiebao":           58,
+	"Browser2345":             59,
+	"BrowserOperaMini":        60,
+	"BrowserPuffin":           61,
+	"BrowserUCMini":           62,
+	"BrowserAppleCoreMedia":   63,
+	"BrowserExoPlayer":        64,
+	"BrowserStagefright":      65,
+	"BrowserVLC":              66,
+	"BrowserFFmpeg":           67,
+	"BrowserRoku":             68,
+	"BrowserOvercast":         69,
+	"BrowserPocketCasts":      70,
+	"BrowseriTunes":           71,
+	"BrowserOutlook":          72,
+	"BrowserThunderbird":      73,
+	"BrowserAppleMail":        74,
+	"BrowserGoogleImageProxy": 75,
+	"BrowserYahooMailProxy":   76,
+}
+
+var osNameIDsV1 = map[string]int{
+	"OSUnknown":      0,
+	"OSWindowsPhone": 1,
+	"OSWindows":      2,
+	"OSMacOSX":       3,
+	"OSiOS":          4,
+	"OSAndroid":      5,
+	"OSBlackberry":   6,
+	"OSChromeOS":     7,
+	"OSKindle":       8,
+	"OSWebOS":        9,
+	"OSLinux":        10,
+	"OSPlaystation":  11,
+	"OSXbox":         12,
+	"OSNintendo":     13,
+	"OSBot":          14,
+	"OSTizen":        15,
+	"OSWebOSTV":      16,
+	"OSRokuOS":       17,
+	"OStvOS":         18,
+	"OSFireOS":       19,
+	"OSAndroidTV":    20,
+	"OSVIDAA":        21,
+	"OSHarmonyOS":    22,
+	"OSOpenHarmony":  23,
+	"OSKaiOS":        24,
+	"OSSeries40":     25,
+	"OSJ2ME":         26,
+}
+
+var platformIDsV1 = map[string]int{
+	"PlatformUnknown":      0,
+	"PlatformWindows":      1,
+	"PlatformMac":          2,
+	"PlatformLinux":        3,
+	"PlatformiPad":         4,
+	"PlatformiPhone":       5,
+	"PlatformiPod":         6,
+	"PlatformBlackberry":   7,
+	"PlatformWindowsPhone": 8,
+	"PlatformPlaystation":  9,
+	"PlatformXbox":         10,
+	"PlatformNintendo":     11,
+	"PlatformBot":          12,
+	"PlatformAppleTV":      13,
+}
+
+// checkIDs verifies the IDs of an enum's constants against the persisted IDs.
+func checkIDs[T ~int](t *testing.T, persisted map[string]int, name func(T) string, id func(T) int, byID func(int) (T, bool), last T) {
+	t.Helper()
+	if s := name(last + 1); !strings.HasSuffix(s, ")") {
+		t.Fatalf("%s is declared after %s, update the test", s, name(last))
+	}
+	seen := make(map[int]T)
+	for v := T(0); v <= last; v++ {
+		want, ok := persisted[name(v)]
+		if !ok {
+			t.Errorf("%s has no persisted ID, add it with the next free ID", name(v))
+			continue
+		}
+		if got := id(v); got != want {
+			t.Errorf("%s: got ID %d, wanted %d", name(v), got, want)
+		}
+		if int(v) != want {
+			t.Errorf("%s: value %d differs from its ID %d, declare new constants at the end of their block", name(v), v, want)
+		}
+		if other, ok := seen[id(v)]; ok {
+			t.Errorf("%s and %s share ID %d", name(v), name(other), id(v))
+		}
+		seen[id(v)] = v
+		if got, ok := byID(want); !ok || got != v {
+			t.Errorf("ByID(%d): got %s, %v, wanted %s", want, name(got), ok, name(v))
+		}
+	}
+	if len(seen) != len(persisted) {
+		t.Errorf("%d persisted IDs, but %d constants", len(persisted), len(seen))
+	}
+}
+
+func TestStableIDs(t *testing.T) {
+	// the last argument is the last constant declared
+	checkIDs(t, deviceTypeIDsV1, DeviceType.String, DeviceType.ID, DeviceTypeByID, DeviceFeaturePhone)
+	checkIDs(t, browserNameIDsV1, BrowserName.String, BrowserName.ID, BrowserNameByID, BrowserYahooMailProxy)
+	checkIDs(t, osNameIDsV1, OSName.String, OSName.ID, OSNameByID, OSJ2ME)
+	checkIDs(t, platformIDsV1, Platform.String, Platform.ID, PlatformByID, PlatformAppleTV)
+
+	if _, ok := BrowserNameByID(-1); ok {
+		t.Error("BrowserNameByID(-1): expected false")
+	}
+	if id := BrowserName(-1).ID(); id != 0 {
+		t.Errorf("BrowserName(-1).ID(): got %d, wanted 0", id)
+	}
+}
+
+func TestBrowserClasses(t *testing.T) {
+	bots := 0
+	for b := BrowserUnknown; int(b) < len(browserNameMeta); b++ {
+		ua := UserAgent{Browser: Browser{Name: b}}
+		if ua.IsBot() {
+			bots++
+		}
+		if ua.IsEmailProxy() && !ua.IsEmailClient() {
+			t.Errorf("%s is an email proxy but not an email client", b)
+		}
+		if ua.IsBot() && (ua.IsMediaPlayer() || ua.IsEmailClient()) {
+			t.Errorf("%s is a bot and another class", b)
+		}
+	}
+	if bots != 14 {
+		t.Errorf("got %d bots, wanted 14", bots)
+	}
+}
@@ -0,0 +1,43 @@
+// Package clienthints reads the values of User-Agent Client Hints headers, for
+// the packages that parse them: uasurfer and openrtb.
+package clienthints
+
+import "strings"
+
+// Brand is a member of a User-Agent Client Hints brand list.
+type Brand struct {
+	Brand   string
+	Version string
+}
+
+// ParseBrands parses the structured header list used by Sec-CH-UA and
+// Sec-CH-UA-Full-Version-List, e.g. "Chromium";v="120", "Brave";v="120".
+// Malformed members are skipped.
+func ParseBrands(s string) []Brand {
+	var brands []Brand
+	for _, member := range strings.Split(s, ",") {
+		params := strings.Split(member, ";")
+		b := Brand{Brand: Unquote(params[0])}
+		if b.Brand == "" {
+			continue
+		}
+		for _, p := range params[1:] {
+			if k, v, ok := strings.Cut(strings.TrimSpace(p), "="); ok && k == "v" {
+				b.Version = Unquote(v)
+			}
+		}
+		brands = append(brands, b)
+	}
+	return brands
+}
+
+// Unquote returns the value of a header holding a quoted string, such as
+// Sec-CH-UA-Platform, e.g. Windows for "Windows". It returns "" if the value
+// isn't quoted.
+func Unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return ""
+}
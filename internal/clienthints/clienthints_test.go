@@ -0,0 +1,46 @@
+package clienthints
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBrands(t *testing.T) {
+	testCases := []struct {
+		header   string
+		expected []Brand
+	}{
+		{`"Chromium";v="120", "Brave";v="120", "Not_A Brand";v="8"`,
+			[]Brand{{"Chromium", "120"}, {"Brave", "120"}, {"Not_A Brand", "8"}}},
+		{`"Chromium";v="142.0.7444.34", "Google Chrome";v="142.0.7444.34"`,
+			[]Brand{{"Chromium", "142.0.7444.34"}, {"Google Chrome", "142.0.7444.34"}}},
+		{`"Chromium", Brave;v="120", "Opera GX";q=1;v="105"`,
+			[]Brand{{"Chromium", ""}, {"Opera GX", "105"}}},
+		{``, nil},
+	}
+
+	for _, tc := range testCases {
+		if got := ParseBrands(tc.header); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("ParseBrands(%q) = %v, expected %v", tc.header, got, tc.expected)
+		}
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	testCases := []struct {
+		header   string
+		expected string
+	}{
+		{`"Windows"`, "Windows"},
+		{` "x86" `, "x86"},
+		{`""`, ""},
+		{`Windows`, ""},
+		{`"`, ""},
+	}
+
+	for _, tc := range testCases {
+		if got := Unquote(tc.header); got != tc.expected {
+			t.Errorf("Unquote(%q) = %q, expected %q", tc.header, got, tc.expected)
+		}
+	}
+}
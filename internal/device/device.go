@@ -0,0 +1,154 @@
+// Package device reads the vendor and model of the device from a raw user
+// agent, for the packages that report them: semconv, openrtb and taxonomy.
+package device
+
+import (
+	"strings"
+
+	"github.com/avct/uasurfer"
+)
+
+// Device contains the vendor and model of the device, where the user agent
+// names them. Text fields are returned as found, without changing case, and
+// Vendor is empty when it cannot be told from the model.
+type Device struct {
+	Vendor string
+	Model  string
+}
+
+// Parse extracts the device vendor and model from a raw user agent: the
+// model Android and Kindle devices report before Build/, the device fields of
+// HbbTV smart TVs, Apple's iPhone, iPad and iPod, and Nokia phones. It
+// returns false if the user agent names no model, as desktop browsers and
+// Chrome's reduced Android user agent (with a model of "K") don't. Fields
+// that only name a locale, such as en-us, are skipped.
+func Parse(ua string) (Device, bool) {
+	if tv, ok := uasurfer.ParseHbbTV(ua); ok && tv.Model != "" {
+		return Device{Vendor: tv.Vendor, Model: tv.Model}, true
+	}
+
+	for _, apple := range []string{"iPhone", "iPad", "iPod"} {
+		if strings.Contains(ua, "("+apple) {
+			return Device{Vendor: "Apple", Model: apple}, true
+		}
+	}
+
+	if strings.HasPrefix(ua, "Nokia") {
+		model := strings.TrimPrefix(ua, "Nokia")
+		if i := strings.IndexAny(model, "/ "); i != -1 {
+			model = model[:i]
+		}
+		if model != "" {
+			return Device{Vendor: "Nokia", Model: model}, true
+		}
+	}
+
+	if model := androidModel(ua); model != "" {
+		return Device{Vendor: modelVendor(model), Model: model}, true
+	}
+	return Device{}, false
+}
+
+// androidModel returns the model from the first parenthesised group of an
+// Android or Kindle user agent, e.g. "SM-G973F" from
+// (Linux; Android 10; SM-G973F Build/QP1A.190711.020; wv).
+func androidModel(ua string) string {
+	s := strings.IndexByte(ua, '(')
+	if s == -1 {
+		return ""
+	}
+	e := strings.IndexByte(ua[s:], ')')
+	if e == -1 {
+		return ""
+	}
+	fields := strings.Split(ua[s+1:s+e], ";")
+
+	for _, f := range fields {
+		if i := indexBuild(f); i != -1 {
+			return strings.TrimSpace(f[:i])
+		}
+	}
+
+	android := false
+	for _, f := range fields {
+		f = strings.TrimSpace(f)
+		switch {
+		case strings.HasPrefix(f, "Android"):
+			android = true
+		case !android, f == "", f == "U", f == "K", f == "wv", f == "Mobile", f == "Tablet", isLocale(f):
+		default:
+			return f
+		}
+	}
+	return ""
+}
+
+// indexBuild returns the index of " Build/" in s, in any case, or -1.
+func indexBuild(s string) int {
+	const build = " build/"
+	for i := 0; i+len(build) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(build)], build) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isLocale reports whether s is a language tag such as en, en-us or zh_CN.
+// Two character models, such as A1, are not.
+func isLocale(s string) bool {
+	switch {
+	case len(s) == 2:
+		return isLetters(s)
+	case len(s) == 5 && (s[2] == '-' || s[2] == '_'):
+		return isLetters(s[:2]) && isLetters(s[3:])
+	}
+	return false
+}
+
+// isLetters reports whether s is made of ASCII letters.
+func isLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// modelVendors maps model name prefixes to vendors.
+var modelVendors = []struct {
+	prefix string
+	vendor string
+}{
+	{"SM-", "Samsung"},
+	{"SAMSUNG", "Samsung"},
+	{"GT-", "Samsung"},
+	{"Pixel", "Google"},
+	{"Nexus", "Google"},
+	{"Redmi", "Xiaomi"},
+	{"Mi ", "Xiaomi"},
+	{"MI ", "Xiaomi"},
+	{"POCO", "Xiaomi"},
+	{"KF", "Amazon"},
+	{"AFT", "Amazon"},
+	{"HUAWEI", "Huawei"},
+	{"Nokia", "Nokia"},
+	{"moto", "Motorola"},
+	{"Moto", "Motorola"},
+	{"LG-", "LG"},
+	{"LM-", "LG"},
+	{"ONEPLUS", "OnePlus"},
+	{"CPH", "Oppo"},
+	{"vivo", "Vivo"},
+	{"V2", "Vivo"},
+}
+
+func modelVendor(model string) string {
+	for _, v := range modelVendors {
+		if strings.HasPrefix(model, v.prefix) {
+			return v.vendor
+		}
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package device
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected Device
+		ok       bool
+	}{
+		{"Mozilla/5.0 (Linux; Android 10; SM-G973F Build/QP1A.190711.020; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/88.0.4324.93 Mobile Safari/537.36",
+			Device{Vendor: "Samsung", Model: "SM-G973F"}, true},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+			Device{Vendor: "Google", Model: "Pixel 8"}, true},
+		{"Mozilla/5.0 (Linux; U; Android 4.0.4; en-us; GT-I9300 Build/IMM76D) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
+			Device{Vendor: "Samsung", Model: "GT-I9300"}, true},
+		{"Mozilla/5.0 (Linux; U; en-us; KFJWI Build/IMM76D) AppleWebKit/535.19 (KHTML like Gecko) Silk/2.4 Safari/535.19 Silk-Acceleratedtrue",
+			Device{Vendor: "Amazon", Model: "KFJWI"}, true},
+		{"Mozilla/5.0 (Linux; Android 11; M2101K6G) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/96.0.4664.104 Mobile Safari/537.36",
+			Device{Model: "M2101K6G"}, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			Device{Vendor: "Apple", Model: "iPhone"}, true},
+		{"Mozilla/5.0 (SMART-TV; LINUX; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) 76.0.3809.146/6.0 TV Safari/537.36 HbbTV/1.5.1 (+DRM;Samsung;SmartTV2021;T-KSU2EDEUC-1520.6;;)",
+			Device{Vendor: "Samsung", Model: "SmartTV2021"}, true},
+		{"Nokia6300/2.0 (05.00) Profile/MIDP-2.0 Configuration/CLDC-1.1",
+			Device{Vendor: "Nokia", Model: "6300"}, true},
+		{"Mozilla/5.0 (Linux; U; Android 8.1.0; zh-CN; A1 Build/OPM1.171019.011) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/57.0.2987.108 Mobile Safari/537.36",
+			Device{Model: "A1"}, true},
+		{"Mozilla/5.0 (Linux; U; Android 4.0.4; pt_BR; Nexus 7 Build/IMM76D) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Safari/534.30",
+			Device{Vendor: "Google", Model: "Nexus 7"}, true},
+		{"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+			Device{}, false},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Device{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			d, ok := Parse(tc.ua)
+			if ok != tc.ok || d != tc.expected {
+				t.Errorf("got %+v, %v, wanted %+v, %v", d, ok, tc.expected, tc.ok)
+				t.Logf("agent: %s", tc.ua)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package uasurfer
+
+// J2ME contains the Java ME profile and configuration that feature phones
+// report in their user agent, e.g. Profile/MIDP-2.0 Configuration/CLDC-1.1.
+type J2ME struct {
+	MIDP Version
+	CLDC Version
+}
+
+// ParseJ2ME extracts the MIDP profile and CLDC configuration versions from a
+// raw user agent. It returns false if the user agent reports neither.
+func ParseJ2ME(ua string) (J2ME, bool) {
+	var j J2ME
+	midp, cldc := false, false
+	if i := indexFold(ua, "midp-"); i != -1 {
+		midp = j.MIDP.parse(ua[i+5:])
+	}
+	if i := indexFold(ua, "cldc-"); i != -1 {
+		cldc = j.CLDC.parse(ua[i+5:])
+	}
+	return j, midp || cldc
+}
@@ -0,0 +1,28 @@
+package uasurfer
+
+import "testing"
+
+func TestParseJ2ME(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected J2ME
+		ok       bool
+	}{
+		{"Nokia6300/2.0 (05.00) Profile/MIDP-2.0 Configuration/CLDC-1.1", J2ME{Version{Major: 2, Minor: 0, Patch: 0, Raw: "2.0"}, Version{Major: 1, Minor: 1, Patch: 0, Raw: "1.1"}}, true},
+		{"Mozilla/5.0 (Series40; Nokia501/14.0.4/java_runtime_version=Nokia_Asha_1_2; Profile/MIDP-2.1 Configuration/CLDC-1.1) Gecko/20100401 S40OviBrowser/4.0.0.0.45", J2ME{Version{Major: 2, Minor: 1, Patch: 0, Raw: "2.1"}, Version{Major: 1, Minor: 1, Patch: 0, Raw: "1.1"}}, true},
+		{"SAMSUNG-GT-E1200/1.0 Profile/MIDP-2.0", J2ME{MIDP: Version{Major: 2, Minor: 0, Patch: 0, Raw: "2.0"}}, true},
+		{"Mozilla/5.0 (Mobile; Nokia_8110_4G; rv:48.0) Gecko/48.0 Firefox/48.0 KAIOS/2.5", J2ME{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			j, ok := ParseJ2ME(tc.ua)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok %v, got %v", tc.ok, ok)
+			}
+			if j != tc.expected {
+				t.Fatalf("Expected %+v, got %+v", tc.expected, j)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package uasurfer
+
+import (
+	"encoding/json"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Enums are marshalled by their String() name, e.g. "BrowserChrome", and
+// unmarshalled as by ParseBrowserName and friends. Versions are marshalled in
+// the form returned by Version.String(), e.g. "45.0.2454", except for the zero
+// Version of an unknown version, which is marshalled as "".
+
+func unmarshalJSONString(data []byte, unmarshalText func([]byte) error) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return unmarshalText([]byte(s))
+}
+
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	dst = appendJSONEscaped(dst, s)
+	return append(dst, '"')
+}
+
+const hex = "0123456789abcdef"
+
+// appendJSONEscaped appends s escaped as encoding/json does, including its
+// escaping of HTML characters, so that AppendJSON matches json.Marshal.
+// Invalid UTF-8 is replaced with U+FFFD.
+func appendJSONEscaped(dst []byte, s string) []byte {
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+		case r == '\u2028' || r == '\u2029':
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+	return append(dst, s[start:]...)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DeviceType) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DeviceType) UnmarshalText(text []byte) error {
+	return parseEnum(deviceTypes, "DeviceType", string(text), d)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DeviceType) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, d.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeviceType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, d.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BrowserName) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BrowserName) UnmarshalText(text []byte) error {
+	return parseEnum(browserNameValues(), "BrowserName", string(text), b)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BrowserName) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, b.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BrowserName) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, b.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f BrowserFamily) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *BrowserFamily) UnmarshalText(text []byte) error {
+	return parseEnum(browserFamilies, "BrowserFamily", string(text), f)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f BrowserFamily) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, f.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *BrowserFamily) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, f.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Channel) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Channel) UnmarshalText(text []byte) error {
+	return parseEnum(channels, "Channel", string(text), c)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Channel) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, c.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Channel) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, c.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o OSName) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OSName) UnmarshalText(text []byte) error {
+	return parseEnum(osNameValues(), "OSName", string(text), o)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OSName) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, o.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OSName) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, o.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Platform) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Platform) UnmarshalText(text []byte) error {
+	return parseEnum(platforms, "Platform", string(text), p)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Platform) MarshalJSON() ([]byte, error) {
+	return appendJSONString(nil, p.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Platform) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, p.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler. The zero Version is
+// marshalled as an empty string.
+func (v Version) MarshalText() ([]byte, error) {
+	if v == (Version{}) {
+		return []byte{}, nil
+	}
+	return v.appendText(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty string is
+// the zero Version.
+func (v *Version) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = Version{}
+		return nil
+	}
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return v.appendJSON(nil), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, v.UnmarshalText)
+}
+
+func (v Version) appendJSON(dst []byte) []byte {
+	if v == (Version{}) {
+		return append(dst, `""`...)
+	}
+	// the numbers need no escaping, but the tag may hold anything
+	pre := v.Pre
+	v.Pre = ""
+	dst = append(dst, '"')
+	dst = v.appendText(dst)
+	dst = appendJSONEscaped(dst, pre)
+	return append(dst, '"')
+}
+
+// MarshalJSON implements json.Marshaler, see AppendJSON.
+func (ua *UserAgent) MarshalJSON() ([]byte, error) {
+	return ua.AppendJSON(make([]byte, 0, 192)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (ua *UserAgent) UnmarshalJSON(data []byte) error {
+	// userAgent has the fields of UserAgent but not its methods, so that the
+	// default decoding applies
+	type userAgent UserAgent
+	return json.Unmarshal(data, (*userAgent)(ua))
+}
+
+// AppendJSON appends the JSON encoding of the UserAgent to dst and returns
+// the extended buffer. It produces the same output as json.Marshal without
+// reflection, and doesn't allocate when dst has enough room, for use in log
+// pipelines:
+//
+//	{"Browser":{"Name":"BrowserChrome","Version":"120.0.6099.109","ServerRendered":false,"Channel":"ChannelUnknown","Engine":"FamilyUnknown"},
+//	"OS":{"Platform":"PlatformWindows","Name":"OSWindows","Version":"10.0.0"},"DeviceType":"DeviceComputer"}
+func (ua *UserAgent) AppendJSON(dst []byte) []byte {
+	dst = append(dst, `{"Browser":{"Name":`...)
+	dst = appendJSONString(dst, ua.Browser.Name.String())
+	dst = append(dst, `,"Version":`...)
+	dst = ua.Browser.Version.appendJSON(dst)
+	dst = append(dst, `,"ServerRendered":`...)
+	dst = strconv.AppendBool(dst, ua.Browser.ServerRendered)
+	dst = append(dst, `,"Channel":`...)
+	dst = appendJSONString(dst, ua.Browser.Channel.String())
+	dst = append(dst, `,"Engine":`...)
+	dst = appendJSONString(dst, ua.Browser.Engine.String())
+	dst = append(dst, `},"OS":{"Platform":`...)
+	dst = appendJSONString(dst, ua.OS.Platform.String())
+	dst = append(dst, `,"Name":`...)
+	dst = appendJSONString(dst, ua.OS.Name.String())
+	dst = append(dst, `,"Version":`...)
+	dst = ua.OS.Version.appendJSON(dst)
+	dst = append(dst, `},"DeviceType":`...)
+	dst = appendJSONString(dst, ua.DeviceType.String())
+	return append(dst, '}')
+}
@@ -0,0 +1,155 @@
+package uasurfer
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	ua := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/45.0.2454.85 Safari/537.36")
+	ua.Browser.Channel = ChannelStable
+
+	const expected = `{"Browser":{"Name":"BrowserChrome","Version":"45.0.2454.85","ServerRendered":false,"Channel":"ChannelStable","Engine":"FamilyUnknown"},"OS":{"Platform":"PlatformWindows","Name":"OSWindows","Version":"10.0.0"},"DeviceType":"DeviceComputer"}`
+
+	b, err := json.Marshal(ua)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != expected {
+		t.Errorf("got %s, wanted %s", b, expected)
+	}
+
+	// AppendJSON must match the default encoding of the struct fields
+	type userAgent UserAgent
+	b, err = json.Marshal((*userAgent)(ua))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != expected {
+		t.Errorf("default encoding: got %s, wanted %s", b, expected)
+	}
+
+	var decoded UserAgent
+	if err := json.Unmarshal([]byte(expected), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Browser.Version.Equal(ua.Browser.Version) || decoded.Browser.Name != ua.Browser.Name ||
+		decoded.Browser.Channel != ua.Browser.Channel || decoded.Browser.Engine != ua.Browser.Engine || decoded.OS.Name != ua.OS.Name ||
+		decoded.OS.Platform != ua.OS.Platform || decoded.DeviceType != ua.DeviceType {
+		t.Errorf("got %+v, wanted %+v", decoded, *ua)
+	}
+}
+
+func TestAppendJSONEscaping(t *testing.T) {
+	for _, s := range []string{"", "plain", `a"b\c`, "tab\tnew\nline\r\x00\x1f", "<b>&amp;", "caf\u00e9 \u2028\u2029", "bad\xffutf8"} {
+		want, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := appendJSONString(nil, s); string(got) != string(want) {
+			t.Errorf("%q: got %s, wanted %s", s, got, want)
+		}
+	}
+
+	// pre-release tags may hold anything set by the caller
+	ua := Parse("Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0b3")
+	ua.Browser.Version.Pre = `b3"}, "x": "\`
+	b := ua.AppendJSON(nil)
+	if !json.Valid(b) {
+		t.Fatalf("invalid JSON: %s", b)
+	}
+	var decoded struct{ Browser struct{ Version string } }
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.Browser.Version != ua.Browser.Version.String() {
+		t.Errorf("got %q, %v from %s", decoded.Browser.Version, err, b)
+	}
+}
+
+func TestMarshalJSONUnknownVersion(t *testing.T) {
+	ua := Parse("Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)")
+
+	const expected = `{"Browser":{"Name":"BrowserGoogleBot","Version":"","ServerRendered":false,"Channel":"ChannelUnknown","Engine":"FamilyUnknown"},"OS":{"Platform":"PlatformBot","Name":"OSBot","Version":""},"DeviceType":"DeviceComputer"}`
+
+	b, err := json.Marshal(ua)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != expected {
+		t.Errorf("got %s, wanted %s", b, expected)
+	}
+
+	type userAgent UserAgent
+	b, err = json.Marshal((*userAgent)(ua))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != expected {
+		t.Errorf("default encoding: got %s, wanted %s", b, expected)
+	}
+
+	var decoded UserAgent
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *ua {
+		t.Errorf("got %+v, wanted %+v", decoded, *ua)
+	}
+}
+
+func TestMarshalTextRoundTrip(t *testing.T) {
+	for b := BrowserUnknown; int(b) < len(browserNameMeta); b++ {
+		text, err := b.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got BrowserName
+		if err := got.UnmarshalText(text); err != nil || got != b {
+			t.Errorf("%s: got %v, %v", text, got, err)
+		}
+	}
+	for o := OSUnknown; int(o) < len(osNameMeta); o++ {
+		var got OSName
+		if err := got.UnmarshalText([]byte(o.String())); err != nil || got != o {
+			t.Errorf("%s: got %v, %v", o, got, err)
+		}
+	}
+	for p := PlatformUnknown; int(p) < len(platformMeta); p++ {
+		var got Platform
+		if err := got.UnmarshalText([]byte(p.String())); err != nil || got != p {
+			t.Errorf("%s: got %v, %v", p, got, err)
+		}
+	}
+	for d := DeviceUnknown; d <= DeviceFeaturePhone; d++ {
+		var got DeviceType
+		if err := got.UnmarshalText([]byte(d.String())); err != nil || got != d {
+			t.Errorf("%s: got %v, %v", d, got, err)
+		}
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	var b BrowserName
+	if err := json.Unmarshal([]byte(`"BrowserNetscape"`), &b); err == nil {
+		t.Error("expected an error for an unknown browser")
+	}
+	if err := json.Unmarshal([]byte(`3`), &b); err == nil {
+		t.Error("expected an error for a number")
+	}
+
+	var v Version
+	if err := json.Unmarshal([]byte(`"45.x"`), &v); err == nil {
+		t.Error("expected an error for an invalid version")
+	}
+	if err := json.Unmarshal([]byte(`""`), &v); err != nil || v != (Version{}) {
+		t.Errorf("empty version: got %+v, %v", v, err)
+	}
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	ua := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/45.0.2454.85 Safari/537.36")
+	buf := make([]byte, 0, 256)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = ua.AppendJSON(buf[:0])
+	}
+}
@@ -0,0 +1,74 @@
+package uasurfer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lookup tables from lowercase enum names, both in full and without their
+// prefix, e.g. "browserchrome" and "chrome", to enum values.
+var (
+	deviceTypes     = enumValues(DeviceType.String, "DeviceType", "Device")
+	browserNames    = enumValues(BrowserName.String, "BrowserName", "Browser")
+	browserFamilies = enumValues(BrowserFamily.String, "BrowserFamily", "Family")
+	channels        = enumValues(Channel.String, "Channel", "Channel")
+	osNames         = enumValues(OSName.String, "OSName", "OS")
+	platforms       = enumValues(Platform.String, "Platform", "Platform")
+)
+
+// enumValues builds the lookup table of an enum from its String method. The
+// enum is read up to the first value formatted as out of range by stringer,
+// e.g. "BrowserName(100)".
+func enumValues[T ~int](name func(T) string, typ, prefix string) map[string]T {
+	m := make(map[string]T)
+	for i := T(0); ; i++ {
+		s := name(i)
+		if strings.HasPrefix(s, typ+"(") {
+			return m
+		}
+		m[strings.ToLower(s)] = i
+		m[strings.ToLower(strings.TrimPrefix(s, prefix))] = i
+	}
+}
+
+func parseEnum[T ~int](values map[string]T, typ, s string, dest *T) error {
+	v, ok := values[strings.ToLower(s)]
+	if !ok {
+		return fmt.Errorf("uasurfer: unknown %s %q", typ, s)
+	}
+	*dest = v
+	return nil
+}
+
+// ParseBrowserName returns the BrowserName named s, as returned by String
+// or StringTrimPrefix, ignoring case: "BrowserIE", "IE" and "ie" all return
+// BrowserIE.
+func ParseBrowserName(s string) (BrowserName, error) {
+	var b BrowserName
+	err := parseEnum(browserNameValues(), "BrowserName", s, &b)
+	return b, err
+}
+
+// ParseOSName returns the OSName named s, as returned by String or
+// StringTrimPrefix, ignoring case.
+func ParseOSName(s string) (OSName, error) {
+	var o OSName
+	err := parseEnum(osNameValues(), "OSName", s, &o)
+	return o, err
+}
+
+// ParsePlatform returns the Platform named s, as returned by String or
+// StringTrimPrefix, ignoring case.
+func ParsePlatform(s string) (Platform, error) {
+	var p Platform
+	err := parseEnum(platforms, "Platform", s, &p)
+	return p, err
+}
+
+// ParseDeviceType returns the DeviceType named s, as returned by String or
+// StringTrimPrefix, ignoring case.
+func ParseDeviceType(s string) (DeviceType, error) {
+	var d DeviceType
+	err := parseEnum(deviceTypes, "DeviceType", s, &d)
+	return d, err
+}
@@ -0,0 +1,52 @@
+package uasurfer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEnumNames(t *testing.T) {
+	for b := BrowserUnknown; int(b) < len(browserNameMeta); b++ {
+		for _, s := range []string{b.String(), b.StringTrimPrefix(), strings.ToUpper(b.StringTrimPrefix())} {
+			if got, err := ParseBrowserName(s); err != nil || got != b {
+				t.Errorf("ParseBrowserName(%q): got %v, %v", s, got, err)
+			}
+		}
+	}
+	for o := OSUnknown; int(o) < len(osNameMeta); o++ {
+		for _, s := range []string{o.String(), o.StringTrimPrefix(), strings.ToLower(o.String())} {
+			if got, err := ParseOSName(s); err != nil || got != o {
+				t.Errorf("ParseOSName(%q): got %v, %v", s, got, err)
+			}
+		}
+	}
+	for p := PlatformUnknown; int(p) < len(platformMeta); p++ {
+		for _, s := range []string{p.String(), p.StringTrimPrefix(), strings.ToLower(p.StringTrimPrefix())} {
+			if got, err := ParsePlatform(s); err != nil || got != p {
+				t.Errorf("ParsePlatform(%q): got %v, %v", s, got, err)
+			}
+		}
+	}
+	for d := DeviceUnknown; d <= DeviceFeaturePhone; d++ {
+		for _, s := range []string{d.String(), d.StringTrimPrefix(), strings.ToUpper(d.String())} {
+			if got, err := ParseDeviceType(s); err != nil || got != d {
+				t.Errorf("ParseDeviceType(%q): got %v, %v", s, got, err)
+			}
+		}
+	}
+}
+
+func TestParseEnumInvalid(t *testing.T) {
+	if b, err := ParseBrowserName("Netscape"); err == nil || b != BrowserUnknown {
+		t.Errorf("ParseBrowserName: got %v, %v", b, err)
+	}
+	if o, err := ParseOSName(""); err == nil || o != OSUnknown {
+		t.Errorf("ParseOSName: got %v, %v", o, err)
+	}
+	if p, err := ParsePlatform("BrowserChrome"); err == nil || p != PlatformUnknown {
+		t.Errorf("ParsePlatform: got %v, %v", p, err)
+	}
+	if d, err := ParseDeviceType("DeviceType(3)"); err == nil || d != DeviceUnknown {
+		t.Errorf("ParseDeviceType: got %v, %v", d, err)
+	}
+}
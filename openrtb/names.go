@@ -0,0 +1,116 @@
+package openrtb
+
+import (
+	"strings"
+
+	"github.com/avct/uasurfer"
+)
+
+// browserBrands holds the client hint brands of browsers that differ from
+// their constant without its prefix. Browsers that send no client hints are
+// named as they are known.
+var browserBrands = map[uasurfer.BrowserName]string{
+	uasurfer.BrowserChrome:    "Google Chrome",
+	uasurfer.BrowserIE:        "Microsoft Edge",
+	uasurfer.BrowserSamsung:   "Samsung Internet",
+	uasurfer.BrowserOperaGX:   "Opera GX",
+	uasurfer.BrowserOperaMini: "Opera Mini",
+	uasurfer.BrowserUCBrowser: "UC Browser",
+	uasurfer.BrowserHuawei:    "Huawei Browser",
+	uasurfer.BrowserCocCoc:    "CocCoc",
+}
+
+// osNames holds the Sec-CH-UA-Platform values of OSes, which are also used
+// for the device os, where they differ from their constant without its
+// prefix.
+var osNames = map[uasurfer.OSName]string{
+	uasurfer.OSMacOSX:       "macOS",
+	uasurfer.OSChromeOS:     "Chrome OS",
+	uasurfer.OSWindowsPhone: "Windows Phone",
+	uasurfer.OSWebOS:        "webOS",
+	uasurfer.OSWebOSTV:      "webOS",
+	uasurfer.OSRokuOS:       "Roku",
+	uasurfer.OSFireOS:       "Fire OS",
+	uasurfer.OSAndroidTV:    "Android TV",
+	uasurfer.OSSeries40:     "Series 40",
+}
+
+// osPlatforms holds the platform of OSes read from a sua object.
+var osPlatforms = map[uasurfer.OSName]uasurfer.Platform{
+	uasurfer.OSWindows:  uasurfer.PlatformWindows,
+	uasurfer.OSMacOSX:   uasurfer.PlatformMac,
+	uasurfer.OSiOS:      uasurfer.PlatformiPhone,
+	uasurfer.OSAndroid:  uasurfer.PlatformLinux,
+	uasurfer.OSChromeOS: uasurfer.PlatformLinux,
+	uasurfer.OSLinux:    uasurfer.PlatformLinux,
+}
+
+// brandBrowsers and brandOSes are the inverse of browserBrands and osNames,
+// keyed by lowercase brand.
+var (
+	brandBrowsers = map[string]uasurfer.BrowserName{}
+	brandOSes     = map[string]uasurfer.OSName{
+		"chromium os": uasurfer.OSChromeOS,
+	}
+)
+
+func init() {
+	for name, brand := range browserBrands {
+		brandBrowsers[strings.ToLower(brand)] = name
+	}
+	for name, brand := range osNames {
+		brandOSes[strings.ToLower(brand)] = name
+	}
+	// webOS is shared by TVs and the older phones and tablets
+	brandOSes["webos"] = uasurfer.OSWebOSTV
+}
+
+// browserBrand returns the brand of the browser. Internet Explorer is told
+// apart from Edge by its version.
+func browserBrand(ua *uasurfer.UserAgent) string {
+	if ua.Browser.Name == uasurfer.BrowserIE && !ua.Browser.IsEdge() {
+		return "Internet Explorer"
+	}
+	if brand, ok := browserBrands[ua.Browser.Name]; ok {
+		return brand
+	}
+	return ua.Browser.Name.StringTrimPrefix()
+}
+
+// browserByBrand returns the browser of a brand, and whether it is one of the
+// generic Chromium brands that more specific brands are listed alongside.
+// GREASE brands, such as "Not_A Brand", are unknown.
+func browserByBrand(brand string) (uasurfer.BrowserName, bool) {
+	switch b := strings.ToLower(brand); b {
+	case "chromium", "google chrome":
+		return uasurfer.BrowserChrome, b == "chromium"
+	case "internet explorer":
+		return uasurfer.BrowserIE, false
+	default:
+		if name, ok := brandBrowsers[b]; ok {
+			return name, false
+		}
+	}
+	name, _ := uasurfer.ParseBrowserName(brand)
+	return name, false
+}
+
+// osName returns the name of the OS, or "" if it is unknown.
+func osName(name uasurfer.OSName) string {
+	if name == uasurfer.OSUnknown {
+		return ""
+	}
+	if s, ok := osNames[name]; ok {
+		return s
+	}
+	return name.StringTrimPrefix()
+}
+
+// osByBrand returns the OS of a platform brand.
+func osByBrand(brand string) uasurfer.OSName {
+	if name, ok := brandOSes[strings.ToLower(brand)]; ok {
+		return name
+	}
+	name, _ := uasurfer.ParseOSName(brand)
+	return name
+}
@@ -0,0 +1,104 @@
+// Package openrtb maps a parsed uasurfer.UserAgent to the device object of an
+// OpenRTB 2.6 bid request, and reads a UserAgent back from the structured user
+// agent (sua) of an incoming one.
+//
+// Only the fields that can be told from the user agent and its client hints
+// are covered. The types marshal to the OpenRTB JSON field names, so they can
+// be copied into, or embedded in, a full bid request.
+package openrtb
+
+import (
+	"net/http"
+
+	"github.com/avct/uasurfer"
+	"github.com/avct/uasurfer/internal/device"
+)
+
+// DeviceType is the type of device, from the AdCOM list of device types used
+// by OpenRTB 2.6.
+type DeviceType int
+
+// The device types. DeviceTypeMobileTablet is the legacy catch-all, and isn't
+// returned, as phones and tablets are told apart.
+const (
+	DeviceTypeUnknown          DeviceType = 0
+	DeviceTypeMobileTablet     DeviceType = 1
+	DeviceTypePersonalComputer DeviceType = 2
+	DeviceTypeConnectedTV      DeviceType = 3
+	DeviceTypePhone            DeviceType = 4
+	DeviceTypeTablet           DeviceType = 5
+	DeviceTypeConnectedDevice  DeviceType = 6
+	DeviceTypeSetTopBox        DeviceType = 7
+)
+
+// Device holds the fields of the OpenRTB device object that describe the user
+// agent. Empty fields are left out of the JSON.
+type Device struct {
+	UA         string     `json:"ua,omitempty"`
+	SUA        *UserAgent `json:"sua,omitempty"`
+	DeviceType DeviceType `json:"devicetype,omitempty"`
+	Make       string     `json:"make,omitempty"`
+	Model      string     `json:"model,omitempty"`
+	OS         string     `json:"os,omitempty"`
+	OSV        string     `json:"osv,omitempty"`
+	HWV        string     `json:"hwv,omitempty"`
+}
+
+// FromUserAgent returns the device object of ua. The raw user agent it was
+// parsed from is reported as ua, and read for the make, model and hardware
+// version. Pass an empty string if it isn't available. The sua object is
+// derived from the parsed user agent, with a source of SourceUserAgent.
+func FromUserAgent(ua *uasurfer.UserAgent, raw string) Device {
+	d := Device{
+		UA:         raw,
+		SUA:        parsedSUA(ua),
+		DeviceType: deviceType(ua),
+		OS:         osName(ua.OS.Name),
+		OSV:        ua.OS.Version.Display(),
+	}
+	if dev, ok := device.Parse(raw); ok {
+		d.Make = dev.Vendor
+		d.Model = dev.Model
+	}
+	if tv, ok := uasurfer.ParseHbbTV(raw); ok {
+		d.HWV = tv.HardwareVersion
+	}
+	return d
+}
+
+// FromHeader returns the device object for the headers of an HTTP request,
+// parsed as with uasurfer.ParseHeader. When the browser sends User-Agent
+// Client Hints, the sua object is built from them rather than from the
+// User-Agent header, and Sec-CH-UA-Model supplies a missing model.
+func FromHeader(h http.Header) Device {
+	raw := h.Get("User-Agent")
+	d := FromUserAgent(uasurfer.ParseHeader(h), raw)
+	if sua := headerSUA(h); sua != nil {
+		d.SUA = sua
+		if d.Model == "" {
+			d.Model = sua.Model
+		}
+	}
+	return d
+}
+
+// deviceType maps the device type of ua to OpenRTB. Roku and Apple TV
+// players are reported as set top boxes rather than connected TVs.
+func deviceType(ua *uasurfer.UserAgent) DeviceType {
+	switch ua.DeviceType {
+	case uasurfer.DeviceComputer:
+		return DeviceTypePersonalComputer
+	case uasurfer.DevicePhone, uasurfer.DeviceFeaturePhone:
+		return DeviceTypePhone
+	case uasurfer.DeviceTablet:
+		return DeviceTypeTablet
+	case uasurfer.DeviceTV:
+		if ua.OS.Name == uasurfer.OSRokuOS || ua.OS.Name == uasurfer.OStvOS || ua.OS.Platform == uasurfer.PlatformAppleTV {
+			return DeviceTypeSetTopBox
+		}
+		return DeviceTypeConnectedTV
+	case uasurfer.DeviceConsole, uasurfer.DeviceWearable:
+		return DeviceTypeConnectedDevice
+	}
+	return DeviceTypeUnknown
+}
@@ -0,0 +1,206 @@
+package openrtb
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/avct/uasurfer"
+)
+
+func TestFromUserAgent(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected Device
+	}{
+		{
+			"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36",
+			Device{
+				SUA: &UserAgent{
+					Browsers: []BrandVersion{{"Google Chrome", []string{"120", "0", "6099", "109"}}},
+					Platform: &BrandVersion{"Android", []string{"10"}},
+					Mobile:   1,
+					Source:   SourceUserAgent,
+				},
+				DeviceType: DeviceTypePhone,
+				Make:       "Samsung",
+				Model:      "SM-G973F",
+				OS:         "Android",
+				OSV:        "10",
+			},
+		},
+		{
+			"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			Device{
+				SUA: &UserAgent{
+					Browsers: []BrandVersion{{"Safari", []string{"17", "1"}}},
+					Platform: &BrandVersion{"macOS", []string{"10", "15", "7"}},
+					Source:   SourceUserAgent,
+				},
+				DeviceType: DeviceTypePersonalComputer,
+				OS:         "macOS",
+				OSV:        "10.15.7",
+			},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko",
+			Device{
+				SUA: &UserAgent{
+					Browsers: []BrandVersion{{"Internet Explorer", []string{"11", "0", "0"}}},
+					Platform: &BrandVersion{"Windows", []string{"0", "1", "0"}},
+					Source:   SourceUserAgent,
+				},
+				DeviceType: DeviceTypePersonalComputer,
+				OS:         "Windows",
+				OSV:        "6.1",
+			},
+		},
+		{
+			"HbbTV/1.5.1 (+DRM;Samsung;SmartTV2021;T-KSU2EDEUC-1520.6;T-KSU2E;) Tizen/6.0",
+			Device{
+				DeviceType: DeviceTypeConnectedTV,
+				Make:       "Samsung",
+				Model:      "SmartTV2021",
+				HWV:        "T-KSU2E",
+			},
+		},
+		{
+			"Roku/DVP-12.0 (12.0.0.4182-88)",
+			Device{
+				DeviceType: DeviceTypeSetTopBox,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			tc.expected.UA = tc.ua
+			got := FromUserAgent(uasurfer.Parse(tc.ua), tc.ua)
+			// only the device fields of the TVs are under test
+			if tc.expected.SUA == nil {
+				got.SUA = nil
+				got.OS, got.OSV = "", ""
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("UA: %s\ngot:      %+v\nexpected: %+v", tc.ua, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFromHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("User-Agent", "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36")
+	h.Set("Sec-CH-UA", `"Not_A Brand";v="8", "Chromium";v="120", "Google Chrome";v="120"`)
+	h.Set("Sec-CH-UA-Mobile", "?1")
+	h.Set("Sec-CH-UA-Platform", `"Android"`)
+	h.Set("Sec-CH-UA-Platform-Version", `"14.0.0"`)
+	h.Set("Sec-CH-UA-Model", `"Pixel 7"`)
+
+	expected := Device{
+		UA: h.Get("User-Agent"),
+		SUA: &UserAgent{
+			Browsers: []BrandVersion{
+				{"Not_A Brand", []string{"8"}},
+				{"Chromium", []string{"120"}},
+				{"Google Chrome", []string{"120"}},
+			},
+			Platform: &BrandVersion{"Android", []string{"14", "0", "0"}},
+			Mobile:   1,
+			Model:    "Pixel 7",
+			Source:   SourceHighEntropy,
+		},
+		DeviceType: DeviceTypePhone,
+		Model:      "Pixel 7",
+		OS:         "Android",
+		OSV:        "10",
+	}
+	if got := FromHeader(h); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got:      %+v\nexpected: %+v", got, expected)
+	}
+
+	h.Del("Sec-CH-UA-Platform-Version")
+	h.Del("Sec-CH-UA-Model")
+	if got := FromHeader(h).SUA.Source; got != SourceLowEntropy {
+		t.Errorf("got source %d, expected %d", got, SourceLowEntropy)
+	}
+}
+
+func TestToUserAgent(t *testing.T) {
+	testCases := []struct {
+		sua      string
+		expected uasurfer.UserAgent
+	}{
+		{
+			`{"browsers":[{"brand":"Not_A Brand","version":["8"]},{"brand":"Chromium","version":["120","0","6099","109"]},{"brand":"Brave","version":["120","0","0","0"]}],"platform":{"brand":"Windows","version":["15","0","0"]},"mobile":0,"source":2}`,
+			uasurfer.UserAgent{
+				Browser:    uasurfer.Browser{Name: uasurfer.BrowserBrave, Version: uasurfer.Version{Major: 120}},
+				OS:         uasurfer.OS{Platform: uasurfer.PlatformWindows, Name: uasurfer.OSWindows, Version: uasurfer.Version{Major: 10}},
+				DeviceType: uasurfer.DeviceComputer,
+			},
+		},
+		{
+			`{"browsers":[{"brand":"Chromium","version":["120"]},{"brand":"Google Chrome","version":["120"]}],"platform":{"brand":"Windows","version":["0","3","0"]}}`,
+			uasurfer.UserAgent{
+				Browser:    uasurfer.Browser{Name: uasurfer.BrowserChrome, Version: uasurfer.Version{Major: 120}},
+				OS:         uasurfer.OS{Platform: uasurfer.PlatformWindows, Name: uasurfer.OSWindows, Version: uasurfer.Version{Major: 6, Minor: 3}},
+				DeviceType: uasurfer.DeviceComputer,
+			},
+		},
+		{
+			`{"browsers":[{"brand":"Microsoft Edge","version":["120","0","2210","91"]},{"brand":"Chromium","version":["120","0","6099","109"]}],"platform":{"brand":"Android","version":["13"]},"mobile":0}`,
+			uasurfer.UserAgent{
+				Browser:    uasurfer.Browser{Name: uasurfer.BrowserIE, Version: uasurfer.Version{Major: 120, Minor: 0, Patch: 2210, Build: 91}},
+				OS:         uasurfer.OS{Platform: uasurfer.PlatformLinux, Name: uasurfer.OSAndroid, Version: uasurfer.Version{Major: 13}},
+				DeviceType: uasurfer.DeviceTablet,
+			},
+		},
+		{
+			`{"browsers":[{"brand":"Samsung Internet","version":["23","0"]}],"platform":{"brand":"Android","version":["14"]},"mobile":1,"model":"SM-S918B"}`,
+			uasurfer.UserAgent{
+				Browser:    uasurfer.Browser{Name: uasurfer.BrowserSamsung, Version: uasurfer.Version{Major: 23}},
+				OS:         uasurfer.OS{Platform: uasurfer.PlatformLinux, Name: uasurfer.OSAndroid, Version: uasurfer.Version{Major: 14}},
+				DeviceType: uasurfer.DevicePhone,
+			},
+		},
+		{
+			`{"platform":{"brand":"Chromium OS","version":["15633","69","0"]}}`,
+			uasurfer.UserAgent{
+				OS:         uasurfer.OS{Platform: uasurfer.PlatformLinux, Name: uasurfer.OSChromeOS, Version: uasurfer.Version{Major: 15633, Minor: 69}},
+				DeviceType: uasurfer.DeviceComputer,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			var sua UserAgent
+			if err := json.Unmarshal([]byte(tc.sua), &sua); err != nil {
+				t.Fatal(err)
+			}
+			got := ToUserAgent(&sua)
+			// compare versions by number
+			got.Browser.Version.Raw, got.OS.Version.Raw = "", ""
+			if *got != tc.expected {
+				t.Errorf("sua: %s\ngot:      %+v\nexpected: %+v", tc.sua, *got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, ua := range []string{
+		"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:121.0) Gecko/20100101 Firefox/121.0",
+	} {
+		parsed := uasurfer.Parse(ua)
+		got := ToUserAgent(FromUserAgent(parsed, ua).SUA)
+		if got.Browser.Name != parsed.Browser.Name || !got.Browser.Version.Equal(parsed.Browser.Version) ||
+			got.OS.Name != parsed.OS.Name || got.OS.Platform != parsed.OS.Platform ||
+			got.DeviceType != parsed.DeviceType {
+			t.Errorf("UA: %s\ngot:      %+v\nexpected: %+v", ua, *got, *parsed)
+		}
+	}
+}
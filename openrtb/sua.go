@@ -0,0 +1,191 @@
+package openrtb
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/avct/uasurfer"
+	"github.com/avct/uasurfer/internal/clienthints"
+)
+
+// Source tells where the sua object was read from.
+type Source int
+
+// The sources of a sua object.
+const (
+	SourceUnknown     Source = 0
+	SourceLowEntropy  Source = 1 // Sec-CH-UA, Sec-CH-UA-Mobile and Sec-CH-UA-Platform only
+	SourceHighEntropy Source = 2 // also the high entropy client hints, such as the full version list
+	SourceUserAgent   Source = 3 // parsed from the User-Agent header
+)
+
+// UserAgent is the OpenRTB structured user agent (sua), which carries the
+// User-Agent Client Hints of the browser.
+type UserAgent struct {
+	Browsers     []BrandVersion `json:"browsers,omitempty"`
+	Platform     *BrandVersion  `json:"platform,omitempty"`
+	Mobile       int            `json:"mobile,omitempty"`
+	Architecture string         `json:"architecture,omitempty"`
+	Bitness      string         `json:"bitness,omitempty"`
+	Model        string         `json:"model,omitempty"`
+	Source       Source         `json:"source,omitempty"`
+}
+
+// BrandVersion is a browser or platform brand, with its version split into
+// components, e.g. {"Chromium", ["120", "0", "6099", "109"]}.
+type BrandVersion struct {
+	Brand   string   `json:"brand"`
+	Version []string `json:"version,omitempty"`
+}
+
+// ToUserAgent returns the UserAgent described by sua. The most specific
+// browser brand is used, so a list of Chromium, Brave and a GREASE brand gives
+// BrowserBrave. Windows versions are translated from the platform version
+// sent in client hints to the NT version Parse returns, so Windows 11 reads
+// as 10.0 as it does in the User-Agent header.
+func ToUserAgent(sua *UserAgent) *uasurfer.UserAgent {
+	ua := new(uasurfer.UserAgent)
+	if sua == nil {
+		return ua
+	}
+
+	var specific bool
+	for _, b := range sua.Browsers {
+		name, chromium := browserByBrand(b.Brand)
+		if name == uasurfer.BrowserUnknown || (specific && chromium) {
+			continue
+		}
+		ua.Browser.Name = name
+		ua.Browser.Version, _ = uasurfer.ParseVersion(strings.Join(b.Version, "."))
+		specific = !chromium
+		if specific {
+			break
+		}
+	}
+
+	if sua.Platform != nil {
+		ua.OS.Name = osByBrand(sua.Platform.Brand)
+		ua.OS.Platform = osPlatforms[ua.OS.Name]
+		if ua.OS.Name == uasurfer.OSWindows {
+			ua.OS.Version = windowsVersion(sua.Platform.Version)
+		} else {
+			ua.OS.Version, _ = uasurfer.ParseVersion(strings.Join(sua.Platform.Version, "."))
+		}
+	}
+
+	switch {
+	case sua.Mobile == 1:
+		ua.DeviceType = uasurfer.DevicePhone
+	case ua.OS.Name == uasurfer.OSAndroid:
+		ua.DeviceType = uasurfer.DeviceTablet
+	case ua.OS.Name == uasurfer.OSiOS:
+		ua.DeviceType = uasurfer.DeviceTablet
+		ua.OS.Platform = uasurfer.PlatformiPad
+	case ua.OS.Platform == uasurfer.PlatformWindows, ua.OS.Platform == uasurfer.PlatformMac, ua.OS.Platform == uasurfer.PlatformLinux:
+		ua.DeviceType = uasurfer.DeviceComputer
+	}
+	return ua
+}
+
+// headerSUA returns the sua object for the client hints in h, or nil if the
+// browser sent none.
+func headerSUA(h http.Header) *UserAgent {
+	brands := h.Get("Sec-CH-UA-Full-Version-List")
+	source := SourceHighEntropy
+	if brands == "" {
+		brands = h.Get("Sec-CH-UA")
+		source = SourceLowEntropy
+	}
+	if brands == "" {
+		return nil
+	}
+
+	sua := &UserAgent{
+		Architecture: clienthints.Unquote(h.Get("Sec-CH-UA-Arch")),
+		Bitness:      clienthints.Unquote(h.Get("Sec-CH-UA-Bitness")),
+		Model:        clienthints.Unquote(h.Get("Sec-CH-UA-Model")),
+		Source:       source,
+	}
+	for _, b := range clienthints.ParseBrands(brands) {
+		sua.Browsers = append(sua.Browsers, BrandVersion{b.Brand, splitVersion(b.Version)})
+	}
+	if platform := clienthints.Unquote(h.Get("Sec-CH-UA-Platform")); platform != "" {
+		sua.Platform = &BrandVersion{Brand: platform}
+		if v := clienthints.Unquote(h.Get("Sec-CH-UA-Platform-Version")); v != "" {
+			sua.Platform.Version = splitVersion(v)
+		}
+	}
+	if h.Get("Sec-CH-UA-Mobile") == "?1" {
+		sua.Mobile = 1
+	}
+	if source == SourceLowEntropy && (sua.Architecture != "" || sua.Bitness != "" || sua.Model != "" || sua.Platform != nil && sua.Platform.Version != nil) {
+		sua.Source = SourceHighEntropy
+	}
+	return sua
+}
+
+// parsedSUA returns the sua object derived from a parsed user agent, or nil
+// if neither its browser nor its OS is known.
+func parsedSUA(ua *uasurfer.UserAgent) *UserAgent {
+	if ua.Browser.Name == uasurfer.BrowserUnknown && ua.OS.Name == uasurfer.OSUnknown {
+		return nil
+	}
+	sua := &UserAgent{Source: SourceUserAgent}
+	if ua.Browser.Name != uasurfer.BrowserUnknown {
+		sua.Browsers = []BrandVersion{{browserBrand(ua), splitVersion(ua.Browser.Version.Display())}}
+	}
+	if ua.OS.Name != uasurfer.OSUnknown {
+		sua.Platform = &BrandVersion{Brand: osName(ua.OS.Name)}
+		if ua.OS.Name == uasurfer.OSWindows {
+			sua.Platform.Version = windowsPlatformVersion(ua.OS.Version)
+		} else {
+			sua.Platform.Version = splitVersion(ua.OS.Version.Display())
+		}
+	}
+	if ua.DeviceType == uasurfer.DevicePhone {
+		sua.Mobile = 1
+	}
+	return sua
+}
+
+// windowsVersions maps the Sec-CH-UA-Platform-Version of Windows before 10,
+// which is 0 followed by the minor NT version, to the NT version.
+var windowsVersions = map[string]uasurfer.Version{
+	"1": {Major: 6, Minor: 1},
+	"2": {Major: 6, Minor: 2},
+	"3": {Major: 6, Minor: 3},
+}
+
+// windowsVersion returns the NT version of a Windows platform version. Any
+// major version above 0 is Windows 10 or 11, which report NT 10.0.
+func windowsVersion(v []string) uasurfer.Version {
+	if len(v) == 0 {
+		return uasurfer.Version{}
+	}
+	if major, err := strconv.Atoi(v[0]); err == nil && major > 0 {
+		return uasurfer.Version{Major: 10}
+	}
+	if len(v) > 1 {
+		return windowsVersions[v[1]]
+	}
+	return uasurfer.Version{}
+}
+
+// windowsPlatformVersion is the inverse of windowsVersion. NT 10.0 is left
+// out, as it can't tell Windows 10 from 11.
+func windowsPlatformVersion(v uasurfer.Version) []string {
+	for minor, nt := range windowsVersions {
+		if v.Major == nt.Major && v.Minor == nt.Minor {
+			return []string{"0", minor, "0"}
+		}
+	}
+	return nil
+}
+
+func splitVersion(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ".")
+}
@@ -0,0 +1,108 @@
+package uasurfer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// PackedSize is the size in bytes of a packed UserAgent.
+const PackedSize = 20
+
+// Pack encodes the browser, OS, platform and device type of the UserAgent,
+// with the major and minor numbers of its versions, into PackedSize bytes for
+// compact storage. Unpack decodes it again.
+//
+// Enums are packed by their stable ID (see BrowserName.ID), and the layout is
+// versioned in the top bits, so a packed value can be unpacked by any later
+// release. The current layout, from the most significant bit of the first
+// byte, is:
+//
+//	bits  field
+//	   3  layout version, 1
+//	   9  browser name ID
+//	   7  OS name ID
+//	   5  platform ID
+//	   4  device type ID
+//	   4  reserved, 0
+//	  32  browser major version
+//	  32  browser minor version
+//	  32  OS major version
+//	  32  OS minor version
+//
+// Version numbers are unsigned and big-endian. Parse caps them at
+// math.MaxInt32, so every UserAgent it returns packs without loss, including
+// browsers that report a build number as their minor version, such as
+// EdgeHTML and Apple Mail. Numbers of UserAgents built by hand are clamped to
+// the range from 0 to math.MaxInt32. Patch and build numbers, pre-release
+// tags, the release channel, ServerRendered and Engine are not packed.
+func (ua *UserAgent) Pack() [PackedSize]byte {
+	var p [PackedSize]byte
+	binary.BigEndian.PutUint32(p[0:], packLayout<<29|
+		uint32(ua.Browser.Name.ID())<<20|
+		uint32(ua.OS.Name.ID())<<13|
+		uint32(ua.OS.Platform.ID())<<8|
+		uint32(ua.DeviceType.ID())<<4)
+	binary.BigEndian.PutUint32(p[4:], packVersion(ua.Browser.Version.Major))
+	binary.BigEndian.PutUint32(p[8:], packVersion(ua.Browser.Version.Minor))
+	binary.BigEndian.PutUint32(p[12:], packVersion(ua.OS.Version.Major))
+	binary.BigEndian.PutUint32(p[16:], packVersion(ua.OS.Version.Minor))
+	return p
+}
+
+// packLayout is the layout version written by Pack.
+const packLayout = 1
+
+func packVersion(n int) uint32 {
+	switch {
+	case n < 0:
+		return 0
+	case n > math.MaxInt32:
+		return math.MaxInt32
+	}
+	return uint32(n)
+}
+
+// Unpack sets the UserAgent to the fields of a value returned by Pack,
+// leaving the fields Pack doesn't store empty. It returns an error if the
+// value uses an unknown layout or IDs this release doesn't know.
+func (ua *UserAgent) Unpack(p [PackedSize]byte) error {
+	h := binary.BigEndian.Uint32(p[0:])
+	if layout := h >> 29; layout != packLayout {
+		return fmt.Errorf("uasurfer: unknown packed layout %d", layout)
+	}
+	field := func(shift, bits uint) int {
+		return int(h >> shift & (1<<bits - 1))
+	}
+	version := func(i int) (int, error) {
+		n := binary.BigEndian.Uint32(p[i:])
+		if n > math.MaxInt32 {
+			return 0, fmt.Errorf("uasurfer: packed version number %d out of range", n)
+		}
+		return int(n), nil
+	}
+
+	var u UserAgent
+	var ok bool
+	if u.Browser.Name, ok = BrowserNameByID(field(20, 9)); !ok {
+		return fmt.Errorf("uasurfer: unknown packed browser ID %d", field(20, 9))
+	}
+	if u.OS.Name, ok = OSNameByID(field(13, 7)); !ok {
+		return fmt.Errorf("uasurfer: unknown packed OS ID %d", field(13, 7))
+	}
+	if u.OS.Platform, ok = PlatformByID(field(8, 5)); !ok {
+		return fmt.Errorf("uasurfer: unknown packed platform ID %d", field(8, 5))
+	}
+	if u.DeviceType, ok = DeviceTypeByID(field(4, 4)); !ok {
+		return fmt.Errorf("uasurfer: unknown packed device type ID %d", field(4, 4))
+	}
+	var err error
+	for i, n := range []*int{&u.Browser.Version.Major, &u.Browser.Version.Minor, &u.OS.Version.Major, &u.OS.Version.Minor} {
+		if *n, err = version(4 + 4*i); err != nil {
+			return err
+		}
+	}
+
+	*ua = u
+	return nil
+}
@@ -0,0 +1,125 @@
+package uasurfer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPackRoundTrip(t *testing.T) {
+	for _, determined := range testUAVars {
+		ua := Parse(determined.UA)
+
+		var got UserAgent
+		if err := got.Unpack(ua.Pack()); err != nil {
+			t.Fatalf("%s: %v", determined.UA, err)
+		}
+
+		want := UserAgent{
+			Browser:    Browser{Name: ua.Browser.Name, Version: Version{Major: ua.Browser.Version.Major, Minor: ua.Browser.Version.Minor}},
+			OS:         OS{Platform: ua.OS.Platform, Name: ua.OS.Name, Version: Version{Major: ua.OS.Version.Major, Minor: ua.OS.Version.Minor}},
+			DeviceType: ua.DeviceType,
+		}
+		if got != want {
+			t.Errorf("got %+v, wanted %+v", got, want)
+			t.Logf("agent: %s", determined.UA)
+		}
+	}
+}
+
+func TestPackLayout(t *testing.T) {
+	// packed values are persisted, so the layout of a given UserAgent must
+	// never change
+	ua := UserAgent{
+		Browser:    Browser{Name: BrowserChrome, Version: Version{Major: 120, Minor: 1, Patch: 6099}},
+		OS:         OS{Platform: PlatformMac, Name: OSMacOSX, Version: Version{Major: 10, Minor: 15, Patch: 7}},
+		DeviceType: DeviceComputer,
+	}
+	packed := [PackedSize]byte{
+		0x20, 0x10, 0x62, 0x10, // layout 1, browser 1, OS 3, platform 2, device 1
+		0, 0, 0, 120,
+		0, 0, 0, 1,
+		0, 0, 0, 10,
+		0, 0, 0, 15,
+	}
+	if got := ua.Pack(); got != packed {
+		t.Errorf("got %x, wanted %x", got, packed)
+	}
+
+	// the zero UserAgent packs the layout version only
+	if p := new(UserAgent).Pack(); p != [PackedSize]byte{0x20} {
+		t.Errorf("zero UserAgent: got %x", p)
+	}
+}
+
+func TestPackLargeVersions(t *testing.T) {
+	for _, s := range []string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.17763",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Mail/3731.600.7",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99999999999999999999.0.0.0 Safari/537.36",
+	} {
+		ua := Parse(s)
+		var got UserAgent
+		if err := got.Unpack(ua.Pack()); err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if got.Browser.Version.Major != ua.Browser.Version.Major || got.Browser.Version.Minor != ua.Browser.Version.Minor {
+			t.Errorf("got browser version %+v, wanted %+v", got.Browser.Version, ua.Browser.Version)
+			t.Logf("agent: %s", s)
+		}
+	}
+
+	// Parse caps version numbers
+	if ua := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99999999999999999999.0.0.0 Safari/537.36"); ua.Browser.Version.Major != math.MaxInt32 {
+		t.Errorf("got major version %d, wanted %d", ua.Browser.Version.Major, math.MaxInt32)
+	}
+
+	// numbers built by hand are clamped
+	ua := UserAgent{
+		Browser: Browser{Version: Version{Major: -1}},
+		OS:      OS{Version: Version{Major: math.MaxInt32, Minor: math.MaxInt32 + 1}},
+	}
+	var got UserAgent
+	if err := got.Unpack(ua.Pack()); err != nil {
+		t.Fatal(err)
+	}
+	if got.Browser.Version.Major != 0 || got.OS.Version.Major != math.MaxInt32 || got.OS.Version.Minor != math.MaxInt32 {
+		t.Errorf("got browser version %+v and OS version %+v", got.Browser.Version, got.OS.Version)
+	}
+}
+
+func TestPackIDsFit(t *testing.T) {
+	for b := BrowserUnknown; int(b) < len(browserNameMeta); b++ {
+		if b.ID() >= 1<<9 {
+			t.Errorf("%s: ID %d doesn't fit the packed layout", b, b.ID())
+		}
+	}
+	for o := OSUnknown; int(o) < len(osNameMeta); o++ {
+		if o.ID() >= 1<<7 {
+			t.Errorf("%s: ID %d doesn't fit the packed layout", o, o.ID())
+		}
+	}
+	for p := PlatformUnknown; int(p) < len(platformMeta); p++ {
+		if p.ID() >= 1<<5 {
+			t.Errorf("%s: ID %d doesn't fit the packed layout", p, p.ID())
+		}
+	}
+	for d := DeviceUnknown; d <= DeviceFeaturePhone; d++ {
+		if d.ID() >= 1<<4 {
+			t.Errorf("%s: ID %d doesn't fit the packed layout", d, d.ID())
+		}
+	}
+}
+
+func TestUnpackInvalid(t *testing.T) {
+	var ua UserAgent
+	for _, p := range [][PackedSize]byte{
+		{},
+		{0x40},          // layout 2
+		{0x3f, 0xf0},    // browser ID 511
+		{0x20, 4: 0x80}, // browser major version above math.MaxInt32
+	} {
+		if err := ua.Unpack(p); err == nil {
+			t.Errorf("Unpack(%x): expected an error", p)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package uasurfer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Browsers, bots and OSes registered at run time are numbered after the
+// constants, so unlike theirs, their values change as constants are added.
+// Their IDs start at a fixed base and follow the order they are registered in,
+// so registering them in the same order, e.g. from init functions, keeps the
+// IDs stable for storage. Both fit in the fields of Pack.
+const (
+	registeredBrowserIDs = 256 // up to 511
+	registeredOSIDs      = 64  // up to 127
+)
+
+// matcher is a registered browser, bot or OS.
+type matcher struct {
+	name     string // without the prefix of its type
+	match    func(ua string) bool
+	token    string // lowercase
+	flags    metaFlag
+	platform Platform
+}
+
+// registrations is a snapshot of the registry. A new one is stored on each
+// registration, so parsing reads it without locking. The lookup tables of
+// names include the constants, and are nil until a browser or OS is
+// registered.
+type registrations struct {
+	browsers     []matcher
+	oses         []matcher
+	browserNames map[string]BrowserName
+	osNames      map[string]OSName
+}
+
+var (
+	registry        atomic.Pointer[registrations]
+	registerMu      sync.Mutex // serialises registrations
+	noRegistrations registrations
+)
+
+// registered returns the current snapshot of the registry.
+func registered() *registrations {
+	if r := registry.Load(); r != nil {
+		return r
+	}
+	return &noRegistrations
+}
+
+// browserNameValues returns the lookup table of browser names, including
+// registered ones.
+func browserNameValues() map[string]BrowserName {
+	if m := registered().browserNames; m != nil {
+		return m
+	}
+	return browserNames
+}
+
+// osNameValues returns the lookup table of OS names, including registered
+// ones.
+func osNameValues() map[string]OSName {
+	if m := registered().osNames; m != nil {
+		return m
+	}
+	return osNames
+}
+
+// RegisterBrowser adds a browser to those Parse identifies and returns its
+// BrowserName. match is called with the lowercase user agent, and the
+// version is read after versionToken, e.g. "acmeapp/", ignoring case. Pass an
+// empty versionToken if the browser reports no version.
+//
+// Registered browsers and bots are tried in the order they are registered,
+// before the built-in rules, so a browser is identified even if its user agent
+// also carries the tokens of Chrome or Safari. The OS and device type are
+// still read by the built-in rules.
+//
+// The String method of the BrowserName returns name with the Browser prefix,
+// and ParseBrowserName accepts it. RegisterBrowser panics if name is empty,
+// already taken or has characters other than ASCII letters, digits and
+// underscores, or if match is nil. It is safe for concurrent use, but is
+// meant to be called from init functions.
+func RegisterBrowser(name string, match func(ua string) bool, versionToken string) BrowserName {
+	return registerBrowser(name, match, versionToken, 0)
+}
+
+// RegisterBot is like RegisterBrowser, but the BrowserName is a bot, for which
+// IsBot returns true and Parse reports the bot OS and platform.
+func RegisterBot(name string, match func(ua string) bool, versionToken string) BrowserName {
+	return registerBrowser(name, match, versionToken, flagBot)
+}
+
+// RegisterOS adds an OS on the given platform to those Parse identifies and
+// returns its OSName. match is called with the lowercase user agent, and the
+// version is read after versionToken, ignoring case.
+//
+// Registered OSes are tried in the order they are registered, before the
+// built-in rules. The String method of the OSName returns name with the OS
+// prefix, and ParseOSName accepts it. RegisterOS panics as RegisterBrowser
+// does.
+func RegisterOS(name string, platform Platform, match func(ua string) bool, versionToken string) OSName {
+	registerMu.Lock()
+	defer registerMu.Unlock()
+
+	r := registered()
+	checkRegistration(name, match, "OS", osNameValues())
+	if len(r.oses) >= registeredOSIDs {
+		panic("uasurfer: too many registered OSes")
+	}
+
+	o := OSName(len(osNameMeta) + len(r.oses))
+	next := *r
+	next.oses = append(r.oses[:len(r.oses):len(r.oses)], matcher{
+		name:     name,
+		match:    match,
+		token:    strings.ToLower(versionToken),
+		platform: platform,
+	})
+	next.osNames = withName(osNameValues(), name, "OS", o)
+	registry.Store(&next)
+	return o
+}
+
+func registerBrowser(name string, match func(ua string) bool, versionToken string, flags metaFlag) BrowserName {
+	registerMu.Lock()
+	defer registerMu.Unlock()
+
+	r := registered()
+	checkRegistration(name, match, "Browser", browserNameValues())
+	if len(r.browsers) >= registeredBrowserIDs {
+		panic("uasurfer: too many registered browsers")
+	}
+
+	b := BrowserName(len(browserNameMeta) + len(r.browsers))
+	next := *r
+	next.browsers = append(r.browsers[:len(r.browsers):len(r.browsers)], matcher{
+		name:  name,
+		match: match,
+		token: strings.ToLower(versionToken),
+		flags: flags,
+	})
+	next.browserNames = withName(browserNameValues(), name, "Browser", b)
+	registry.Store(&next)
+	return b
+}
+
+func checkRegistration[T ~int](name string, match func(string) bool, prefix string, values map[string]T) {
+	if name == "" {
+		panic("uasurfer: registered name is empty")
+	}
+	if !isIdentifier(name) {
+		panic(fmt.Sprintf("uasurfer: registered name %q is not made of letters, digits and underscores", name))
+	}
+	if match == nil {
+		panic(fmt.Sprintf("uasurfer: %s%s registered without a match func", prefix, name))
+	}
+	if _, ok := values[strings.ToLower(name)]; ok {
+		panic(fmt.Sprintf("uasurfer: %s%s is already taken", prefix, name))
+	}
+	if _, ok := values[strings.ToLower(prefix+name)]; ok {
+		panic(fmt.Sprintf("uasurfer: %s%s is already taken", prefix, name))
+	}
+}
+
+// isIdentifier reports whether s is made of ASCII letters, digits and
+// underscores, like the names of the constants.
+func isIdentifier(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// withName returns a copy of values with name added, in full and without its
+// prefix, as enumValues does.
+func withName[T ~int](values map[string]T, name, prefix string, v T) map[string]T {
+	m := make(map[string]T, len(values)+2)
+	for k, e := range values {
+		m[k] = e
+	}
+	m[strings.ToLower(prefix+name)] = v
+	m[strings.ToLower(name)] = v
+	return m
+}
+
+// browser returns the registration of b, and false if b is not registered.
+func (r *registrations) browser(b BrowserName) (matcher, bool) {
+	i := int(b) - len(browserNameMeta)
+	if i < 0 || i >= len(r.browsers) {
+		return matcher{}, false
+	}
+	return r.browsers[i], true
+}
+
+// os returns the registration of o, and false if o is not registered.
+func (r *registrations) os(o OSName) (matcher, bool) {
+	i := int(o) - len(osNameMeta)
+	if i < 0 || i >= len(r.oses) {
+		return matcher{}, false
+	}
+	return r.oses[i], true
+}
+
+// String returns the name of the constant, e.g. "BrowserChrome", or of the
+// registered browser.
+func (b BrowserName) String() string {
+	if m, ok := registered().browser(b); ok {
+		return "Browser" + m.name
+	}
+	return b.constString()
+}
+
+// String returns the name of the constant, e.g. "OSAndroid", or of the
+// registered OS.
+func (o OSName) String() string {
+	if m, ok := registered().os(o); ok {
+		return "OS" + m.name
+	}
+	return o.constString()
+}
+
+// evalRegisteredBrowser sets the first registered browser or bot that matches
+// ua, with its version.
+func (u *UserAgent) evalRegisteredBrowser(ua string) bool {
+	r := registered()
+	for i, m := range r.browsers {
+		if !m.match(ua) {
+			continue
+		}
+		u.Browser.Name = BrowserName(len(browserNameMeta) + i)
+		if m.token != "" {
+			u.Browser.Version.findVersionNumber(ua, m.token)
+		}
+		return true
+	}
+	return false
+}
+
+// evalRegisteredOS sets the first registered OS that matches ua, with its
+// platform and version.
+func (u *UserAgent) evalRegisteredOS(ua string) bool {
+	r := registered()
+	for i, m := range r.oses {
+		if !m.match(ua) {
+			continue
+		}
+		u.OS.Name = OSName(len(osNameMeta) + i)
+		u.OS.Platform = m.platform
+		if m.token != "" {
+			u.OS.Version.findVersionNumber(ua, m.token)
+		}
+		return true
+	}
+	return false
+}
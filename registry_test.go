@@ -0,0 +1,151 @@
+package uasurfer
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// restoreRegistry undoes the registrations made by a test.
+func restoreRegistry(t *testing.T) {
+	r := registry.Load()
+	t.Cleanup(func() { registry.Store(r) })
+}
+
+func TestRegisterBrowser(t *testing.T) {
+	restoreRegistry(t)
+
+	acme := RegisterBrowser("AcmeApp", func(ua string) bool {
+		return strings.Contains(ua, "acmeapp/")
+	}, "acmeapp/")
+
+	if got := acme.String(); got != "BrowserAcmeApp" {
+		t.Errorf("got %s, wanted BrowserAcmeApp", got)
+	}
+	if got := acme.StringTrimPrefix(); got != "AcmeApp" {
+		t.Errorf("got %s, wanted AcmeApp", got)
+	}
+
+	// registered browsers are matched before Chrome
+	ua := Parse("Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36 AcmeApp/3.2.1")
+	if ua.Browser.Name != acme {
+		t.Errorf("got %s, wanted %s", ua.Browser.Name, acme)
+	}
+	if v := numbers(ua.Browser.Version); v != (Version{Major: 3, Minor: 2, Patch: 1}) {
+		t.Errorf("got version %v, wanted 3.2.1", v)
+	}
+	if ua.OS.Name != OSAndroid || ua.DeviceType != DevicePhone {
+		t.Errorf("got %s %s, wanted OSAndroid DevicePhone", ua.OS.Name, ua.DeviceType)
+	}
+	if ua.IsBot() {
+		t.Error("AcmeApp is not a bot")
+	}
+
+	// other user agents are unaffected
+	if got := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36").Browser.Name; got != BrowserChrome {
+		t.Errorf("got %s, wanted BrowserChrome", got)
+	}
+
+	for _, s := range []string{"BrowserAcmeApp", "acmeapp"} {
+		if got, err := ParseBrowserName(s); err != nil || got != acme {
+			t.Errorf("ParseBrowserName(%q): got %s, %v", s, got, err)
+		}
+	}
+
+	id := acme.ID()
+	if id != registeredBrowserIDs {
+		t.Errorf("got ID %d, wanted %d", id, registeredBrowserIDs)
+	}
+	if got, ok := BrowserNameByID(id); !ok || got != acme {
+		t.Errorf("BrowserNameByID(%d): got %s, %v", id, got, ok)
+	}
+	if _, ok := BrowserNameByID(id + 1); ok {
+		t.Errorf("BrowserNameByID(%d) found an unregistered browser", id+1)
+	}
+
+	var unpacked UserAgent
+	if err := unpacked.Unpack(ua.Pack()); err != nil || unpacked.Browser.Name != acme {
+		t.Errorf("Unpack: got %s, %v", unpacked.Browser.Name, err)
+	}
+
+	data, err := json.Marshal(ua)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded UserAgent
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Browser.Name != acme {
+		t.Errorf("json: got %s, %v from %s", decoded.Browser.Name, err, data)
+	}
+}
+
+func TestRegisterBot(t *testing.T) {
+	restoreRegistry(t)
+
+	crawler := RegisterBot("PartnerCrawler", func(ua string) bool {
+		return strings.Contains(ua, "partnercrawler")
+	}, "partnercrawler/")
+
+	ua := Parse("Mozilla/5.0 (compatible; PartnerCrawler/2.4; +https://partner.example.com/crawler)")
+	if ua.Browser.Name != crawler || numbers(ua.Browser.Version) != (Version{Major: 2, Minor: 4}) {
+		t.Errorf("got %s %v, wanted %s 2.4", ua.Browser.Name, ua.Browser.Version, crawler)
+	}
+	if !ua.IsBot() || ua.OS.Name != OSBot || ua.OS.Platform != PlatformBot {
+		t.Errorf("got %s %s, wanted a bot", ua.OS.Name, ua.OS.Platform)
+	}
+}
+
+func TestRegisterOS(t *testing.T) {
+	restoreRegistry(t)
+
+	steam := RegisterOS("SteamOS", PlatformLinux, func(ua string) bool {
+		return strings.Contains(ua, "steamos")
+	}, "steamos/")
+
+	ua := Parse("Mozilla/5.0 (X11; Linux x86_64; SteamOS/3.5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	if ua.OS.Name != steam || ua.OS.Platform != PlatformLinux || numbers(ua.OS.Version) != (Version{Major: 3, Minor: 5}) {
+		t.Errorf("got %s %s %v, wanted %s PlatformLinux 3.5", ua.OS.Name, ua.OS.Platform, ua.OS.Version, steam)
+	}
+	if ua.Browser.Name != BrowserChrome {
+		t.Errorf("got %s, wanted BrowserChrome", ua.Browser.Name)
+	}
+	if got := steam.String(); got != "OSSteamOS" {
+		t.Errorf("got %s, wanted OSSteamOS", got)
+	}
+	if got, err := ParseOSName("steamos"); err != nil || got != steam {
+		t.Errorf("ParseOSName: got %s, %v", got, err)
+	}
+	if got, ok := OSNameByID(steam.ID()); !ok || got != steam {
+		t.Errorf("OSNameByID(%d): got %s, %v", steam.ID(), got, ok)
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	restoreRegistry(t)
+	match := func(string) bool { return false }
+
+	for _, tc := range []struct {
+		desc     string
+		register func()
+	}{
+		{"empty name", func() { RegisterBrowser("", match, "") }},
+		{"nil match", func() { RegisterBrowser("Acme", nil, "") }},
+		{"quote in name", func() { RegisterBrowser(`Acme"App`, match, "") }},
+		{"space in name", func() { RegisterOS("Acme OS", PlatformLinux, match, "") }},
+		{"built-in name", func() { RegisterBrowser("chrome", match, "") }},
+		{"built-in full name", func() { RegisterBot("BrowserGoogleBot", match, "") }},
+		{"built-in OS", func() { RegisterOS("Android", PlatformLinux, match, "") }},
+		{"registered twice", func() {
+			RegisterBrowser("Twice", match, "")
+			RegisterBrowser("twice", match, "")
+		}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			tc.register()
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package semconv
+
+import "github.com/avct/uasurfer"
+
+// browserNames holds the display names of browsers that differ from their
+// constant without its prefix.
+var browserNames = map[uasurfer.BrowserName]string{
+	uasurfer.BrowserIE:               "Internet Explorer",
+	uasurfer.BrowserUCBrowser:        "UC Browser",
+	uasurfer.BrowserSogouExplorer:    "Sogou Explorer",
+	uasurfer.BrowserSamsung:          "Samsung Internet",
+	uasurfer.BrowserCocCoc:           "Coc Coc",
+	uasurfer.BrowserHuawei:           "Huawei Browser",
+	uasurfer.BrowserOperaGX:          "Opera GX",
+	uasurfer.BrowserOperaMini:        "Opera Mini",
+	uasurfer.BrowserUCMini:           "UC Mini",
+	uasurfer.BrowserPaleMoon:         "Pale Moon",
+	uasurfer.BrowserKMeleon:          "K-Meleon",
+	uasurfer.BrowserFirefoxFocus:     "Firefox Focus",
+	uasurfer.Browser360:              "360 Secure Browser",
+	uasurfer.BrowserMIUI:             "Mi Browser",
+	uasurfer.BrowserVivo:             "Vivo Browser",
+	uasurfer.BrowserHeyTap:           "HeyTap Browser",
+	uasurfer.Browser2345:             "2345 Explorer",
+	uasurfer.BrowserAppleCoreMedia:   "AppleCoreMedia",
+	uasurfer.BrowserPocketCasts:      "Pocket Casts",
+	uasurfer.BrowserAppleMail:        "Apple Mail",
+	uasurfer.BrowserGoogleImageProxy: "Gmail Image Proxy",
+	uasurfer.BrowserYahooMailProxy:   "Yahoo Mail Proxy",
+	uasurfer.BrowserGoogleBot:        "Googlebot",
+	uasurfer.BrowserBingBot:          "Bingbot",
+	uasurfer.BrowserAppleBot:         "Applebot",
+}
+
+// osNames holds the display names of OSes that differ from their constant
+// without its prefix.
+var osNames = map[uasurfer.OSName]string{
+	uasurfer.OSWindowsPhone: "Windows Phone",
+	uasurfer.OSMacOSX:       "macOS",
+	uasurfer.OSChromeOS:     "Chrome OS",
+	uasurfer.OSWebOS:        "webOS",
+	uasurfer.OSWebOSTV:      "webOS TV",
+	uasurfer.OSPlaystation:  "PlayStation",
+	uasurfer.OSRokuOS:       "Roku OS",
+	uasurfer.OSFireOS:       "Fire OS",
+	uasurfer.OSAndroidTV:    "Android TV",
+	uasurfer.OSSeries40:     "Series 40",
+	uasurfer.OSJ2ME:         "Java ME",
+}
+
+// browserName returns the display name of the browser, or "" if it is
+// unknown. Edge is told apart from Internet Explorer by its version.
+func browserName(ua *uasurfer.UserAgent) string {
+	switch ua.Browser.Name {
+	case uasurfer.BrowserUnknown:
+		return ""
+	case uasurfer.BrowserIE:
+		if ua.Browser.IsEdge() {
+			return "Edge"
+		}
+	}
+	if name, ok := browserNames[ua.Browser.Name]; ok {
+		return name
+	}
+	return ua.Browser.Name.StringTrimPrefix()
+}
+
+// osName returns the display name of the OS, or "" if it is unknown or the
+// user agent is a bot, which doesn't tell the OS it runs on.
+func osName(ua *uasurfer.UserAgent) string {
+	switch ua.OS.Name {
+	case uasurfer.OSUnknown, uasurfer.OSBot:
+		return ""
+	}
+	if name, ok := osNames[ua.OS.Name]; ok {
+		return name
+	}
+	return ua.OS.Name.StringTrimPrefix()
+}
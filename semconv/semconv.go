@@ -0,0 +1,107 @@
+// Package semconv converts a parsed uasurfer.UserAgent into the attributes of
+// the OpenTelemetry semantic conventions and the Elastic Common Schema, so
+// that services can attach them to traces and logs without mapping fields by
+// hand.
+//
+// Attributes are returned as plain key/value pairs, to be converted with
+// e.g. attribute.String(a.Key, a.Value) in OpenTelemetry. Attributes the user
+// agent doesn't tell, such as an unknown version or the OS of a bot, are left
+// out.
+package semconv
+
+import (
+	"github.com/avct/uasurfer"
+	"github.com/avct/uasurfer/internal/device"
+)
+
+// Attribute is a key/value pair in one of the schemas.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// OTel returns the OpenTelemetry attributes of ua. The raw user agent it was
+// parsed from is reported as user_agent.original, and read for the device
+// model. Pass an empty string if it isn't available.
+//
+// See https://opentelemetry.io/docs/specs/semconv/attributes-registry/user-agent/
+// and https://opentelemetry.io/docs/specs/semconv/attributes-registry/device/
+func OTel(ua *uasurfer.UserAgent, original string) []Attribute {
+	var attrs []Attribute
+	add := func(k, v string) {
+		if v != "" {
+			attrs = append(attrs, Attribute{k, v})
+		}
+	}
+
+	add("user_agent.original", original)
+	add("user_agent.name", browserName(ua))
+	add("user_agent.version", ua.Browser.Version.Display())
+	if name := osName(ua); name != "" {
+		add("user_agent.os.name", name)
+		add("user_agent.os.version", ua.OS.Version.Display())
+	}
+	if ua.IsBot() {
+		add("user_agent.synthetic.type", "bot")
+	}
+	if d, ok := device.Parse(original); ok {
+		add("device.manufacturer", d.Vendor)
+		add("device.model.name", d.Model)
+	}
+	return attrs
+}
+
+// ECS returns the Elastic Common Schema user_agent fields of ua. The raw user
+// agent it was parsed from is reported as user_agent.original, and read for
+// the device name. Pass an empty string if it isn't available.
+//
+// See https://www.elastic.co/guide/en/ecs/current/ecs-user_agent.html
+func ECS(ua *uasurfer.UserAgent, original string) []Attribute {
+	var attrs []Attribute
+	add := func(k, v string) {
+		if v != "" {
+			attrs = append(attrs, Attribute{k, v})
+		}
+	}
+
+	add("user_agent.original", original)
+	add("user_agent.name", browserName(ua))
+	add("user_agent.version", ua.Browser.Version.Display())
+	if d, ok := device.Parse(original); ok {
+		add("user_agent.device.name", d.Model)
+	}
+
+	name := osName(ua)
+	if name == "" {
+		return attrs
+	}
+	v := ua.OS.Version.Display()
+	add("user_agent.os.name", name)
+	add("user_agent.os.version", v)
+	if v != "" {
+		add("user_agent.os.full", name+" "+v)
+	} else {
+		add("user_agent.os.full", name)
+	}
+	add("user_agent.os.type", ecsOSTypes[ua.OS.Name])
+	return attrs
+}
+
+// ecsOSTypes maps OSes to the values allowed for user_agent.os.type.
+var ecsOSTypes = map[uasurfer.OSName]string{
+	uasurfer.OSWindows:      "windows",
+	uasurfer.OSWindowsPhone: "windows",
+	uasurfer.OSMacOSX:       "macos",
+	uasurfer.OSiOS:          "ios",
+	uasurfer.OStvOS:         "ios",
+	uasurfer.OSAndroid:      "android",
+	uasurfer.OSAndroidTV:    "android",
+	uasurfer.OSFireOS:       "android",
+	uasurfer.OSKindle:       "android",
+	uasurfer.OSLinux:        "linux",
+	uasurfer.OSChromeOS:     "linux",
+	uasurfer.OSTizen:        "linux",
+	uasurfer.OSWebOS:        "linux",
+	uasurfer.OSWebOSTV:      "linux",
+	uasurfer.OSKaiOS:        "linux",
+}
@@ -0,0 +1,98 @@
+package semconv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/avct/uasurfer"
+)
+
+func TestOTel(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected []Attribute
+	}{
+		{
+			"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36",
+			[]Attribute{
+				{"user_agent.original", "Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36"},
+				{"user_agent.name", "Chrome"},
+				{"user_agent.version", "120.0.6099.109"},
+				{"user_agent.os.name", "Android"},
+				{"user_agent.os.version", "10"},
+				{"device.manufacturer", "Samsung"},
+				{"device.model.name", "SM-G973F"},
+			},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			[]Attribute{
+				{"user_agent.original", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91"},
+				{"user_agent.name", "Edge"},
+				{"user_agent.version", "120.0.2210.91"},
+				{"user_agent.os.name", "Windows"},
+				{"user_agent.os.version", "10.0"},
+			},
+		},
+		{
+			"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			[]Attribute{
+				{"user_agent.original", "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"},
+				{"user_agent.name", "Googlebot"},
+				{"user_agent.synthetic.type", "bot"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			got := OTel(uasurfer.Parse(tc.ua), tc.ua)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("got %v, wanted %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestECS(t *testing.T) {
+	const ua = "Mozilla/5.0 (iPhone; CPU iPhone OS 12_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.0 Mobile/15E148 Safari/604.1"
+	expected := []Attribute{
+		{"user_agent.original", ua},
+		{"user_agent.name", "Safari"},
+		{"user_agent.version", "12.0"},
+		{"user_agent.device.name", "iPhone"},
+		{"user_agent.os.name", "iOS"},
+		{"user_agent.os.version", "12.1"},
+		{"user_agent.os.full", "iOS 12.1"},
+		{"user_agent.os.type", "ios"},
+	}
+
+	if got := ECS(uasurfer.Parse(ua), ua); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, wanted %v", got, expected)
+	}
+
+	// without the raw user agent
+	expected = []Attribute{
+		{"user_agent.name", "Firefox"},
+		{"user_agent.version", "121.0"},
+		{"user_agent.os.name", "Linux"},
+		{"user_agent.os.full", "Linux"},
+		{"user_agent.os.type", "linux"},
+	}
+	if got := ECS(uasurfer.Parse("Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0"), ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, wanted %v", got, expected)
+	}
+
+	// bots and unknown OSes have no OS fields
+	for _, ua := range []string{
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		"Mozilla/5.0 (Symbian/3; Series60/5.3 NokiaN8-00/111.040.1511; Profile/MIDP-2.1 Configuration/CLDC-1.1 ) AppleWebKit/535.1 (KHTML, like Gecko) NokiaBrowser/8.3.1.4 Mobile Safari/535.1",
+	} {
+		for _, a := range ECS(uasurfer.Parse(ua), "") {
+			if strings.HasPrefix(a.Key, "user_agent.os.") {
+				t.Errorf("%s: got %v", ua, a)
+			}
+		}
+	}
+}
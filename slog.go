@@ -0,0 +1,76 @@
+//go:build go1.21
+
+package uasurfer
+
+import "log/slog"
+
+// LogKeys are the attribute keys used to log a UserAgent with log/slog.
+type LogKeys struct {
+	Browser  string // group of the browser name and version
+	OS       string // group of the OS name, version and platform
+	Device   string // device type
+	Name     string // browser and OS name within their group
+	Version  string // browser and OS version within their group
+	Platform string // platform within the OS group
+}
+
+// DefaultLogKeys are the keys used by the LogValue methods, which log
+// e.g. ua.browser.name=Chrome ua.browser.version=120.0.0 with a TextHandler.
+// Use the methods of LogKeys directly to log with other keys.
+var DefaultLogKeys = LogKeys{
+	Browser:  "browser",
+	OS:       "os",
+	Device:   "device",
+	Name:     "name",
+	Version:  "version",
+	Platform: "platform",
+}
+
+// UserAgentValue returns ua as a group of browser, OS and device attributes.
+// Names are logged without their prefix, e.g. "Chrome" rather than
+// "BrowserChrome".
+func (k LogKeys) UserAgentValue(ua UserAgent) slog.Value {
+	return slog.GroupValue(
+		slog.Attr{Key: k.Browser, Value: k.BrowserValue(ua.Browser)},
+		slog.Attr{Key: k.OS, Value: k.OSValue(ua.OS)},
+		slog.String(k.Device, ua.DeviceType.StringTrimPrefix()),
+	)
+}
+
+// BrowserValue returns b as a group of name and version attributes.
+func (k LogKeys) BrowserValue(b Browser) slog.Value {
+	return slog.GroupValue(
+		slog.String(k.Name, b.Name.StringTrimPrefix()),
+		slog.Attr{Key: k.Version, Value: b.Version.LogValue()},
+	)
+}
+
+// OSValue returns o as a group of name, version and platform attributes.
+func (k LogKeys) OSValue(o OS) slog.Value {
+	return slog.GroupValue(
+		slog.String(k.Name, o.Name.StringTrimPrefix()),
+		slog.Attr{Key: k.Version, Value: o.Version.LogValue()},
+		slog.String(k.Platform, o.Platform.StringTrimPrefix()),
+	)
+}
+
+// LogValue implements slog.LogValuer, using DefaultLogKeys.
+func (ua UserAgent) LogValue() slog.Value {
+	return DefaultLogKeys.UserAgentValue(ua)
+}
+
+// LogValue implements slog.LogValuer, using DefaultLogKeys.
+func (b Browser) LogValue() slog.Value {
+	return DefaultLogKeys.BrowserValue(b)
+}
+
+// LogValue implements slog.LogValuer, using DefaultLogKeys.
+func (o OS) LogValue() slog.Value {
+	return DefaultLogKeys.OSValue(o)
+}
+
+// LogValue implements slog.LogValuer, logging the version as returned by
+// String, e.g. "120.0.6099.109".
+func (v Version) LogValue() slog.Value {
+	return slog.StringValue(v.String())
+}
@@ -0,0 +1,59 @@
+//go:build go1.21
+
+package uasurfer
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func logLine(f func(*slog.Logger)) string {
+	var buf bytes.Buffer
+	h := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	f(slog.New(h))
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValue(t *testing.T) {
+	ua := Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Safari/537.36")
+
+	testCases := []struct {
+		log      func(*slog.Logger)
+		expected string
+	}{
+		{
+			func(l *slog.Logger) { l.Info("", "ua", ua) },
+			"ua.browser.name=Chrome ua.browser.version=120.0.6099.109 ua.os.name=Windows ua.os.version=10.0.0 ua.os.platform=Windows ua.device=Computer",
+		},
+		{
+			func(l *slog.Logger) { l.Info("", "ua", *ua) },
+			"ua.browser.name=Chrome ua.browser.version=120.0.6099.109 ua.os.name=Windows ua.os.version=10.0.0 ua.os.platform=Windows ua.device=Computer",
+		},
+		{
+			func(l *slog.Logger) { l.Info("", "browser", ua.Browser, "os_version", ua.OS.Version) },
+			"browser.name=Chrome browser.version=120.0.6099.109 os_version=10.0.0",
+		},
+		{
+			func(l *slog.Logger) {
+				keys := LogKeys{Browser: "agent", OS: "system", Device: "device_type", Name: "family", Version: "ver", Platform: "platform"}
+				l.Info("", slog.Any("user_agent", keys.UserAgentValue(*ua)))
+			},
+			"user_agent.agent.family=Chrome user_agent.agent.ver=120.0.6099.109 user_agent.system.family=Windows user_agent.system.ver=10.0.0 user_agent.system.platform=Windows user_agent.device_type=Computer",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := logLine(tc.log); got != tc.expected {
+			t.Errorf("got %s, wanted %s", got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package uasurfer
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Enums are stored in databases by their String() name, which doesn't change
+// when constants are added, and scanned as by ParseBrowserName and friends.
+
+// scanText returns the text of a value scanned from a database. A NULL is
+// returned as the empty string.
+func scanText(src interface{}, typ string) (string, error) {
+	switch v := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", fmt.Errorf("uasurfer: cannot scan %T into %s", src, typ)
+}
+
+func scanEnum[T ~int](values map[string]T, typ string, src interface{}, dest *T) error {
+	s, err := scanText(src, typ)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*dest = 0
+		return nil
+	}
+	return parseEnum(values, typ, s, dest)
+}
+
+// Value implements driver.Valuer.
+func (d DeviceType) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as DeviceUnknown.
+func (d *DeviceType) Scan(src interface{}) error {
+	return scanEnum(deviceTypes, "DeviceType", src, d)
+}
+
+// Value implements driver.Valuer.
+func (b BrowserName) Value() (driver.Value, error) {
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as BrowserUnknown.
+func (b *BrowserName) Scan(src interface{}) error {
+	return scanEnum(browserNameValues(), "BrowserName", src, b)
+}
+
+// Value implements driver.Valuer.
+func (f BrowserFamily) Value() (driver.Value, error) {
+	return f.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as FamilyUnknown.
+func (f *BrowserFamily) Scan(src interface{}) error {
+	return scanEnum(browserFamilies, "BrowserFamily", src, f)
+}
+
+// Value implements driver.Valuer.
+func (c Channel) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as ChannelUnknown.
+func (c *Channel) Scan(src interface{}) error {
+	return scanEnum(channels, "Channel", src, c)
+}
+
+// Value implements driver.Valuer.
+func (o OSName) Value() (driver.Value, error) {
+	return o.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as OSUnknown.
+func (o *OSName) Scan(src interface{}) error {
+	return scanEnum(osNameValues(), "OSName", src, o)
+}
+
+// Value implements driver.Valuer.
+func (p Platform) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// Scan implements sql.Scanner. NULL scans as PlatformUnknown.
+func (p *Platform) Scan(src interface{}) error {
+	return scanEnum(platforms, "Platform", src, p)
+}
+
+// versionWidth is the number of digits each number of a Version is padded to
+// when stored in a database. Larger numbers are stored in full, but don't
+// sort correctly.
+const versionWidth = 8
+
+// Value implements driver.Valuer. The version is stored as text that sorts
+// in the order of Less under byte-wise collation, such as COLLATE "C" in
+// Postgres or the default BINARY collation of SQLite; collations that follow
+// a locale may ignore the punctuation and sort it differently. Each number is
+// zero padded, and the numbers are followed by "~" for a release or by "-"
+// and the tag for a pre-release, with the number ending the tag also zero
+// padded, e.g. "00000120.00000000.00006099.00000109~" or
+// "00000121.00000000.00000000.00000000-b00000003".
+func (v Version) Value() (driver.Value, error) {
+	b := make([]byte, 0, 5*(versionWidth+1)+len(v.Pre))
+	for i, n := range [...]int{v.Major, v.Minor, v.Patch, v.Build} {
+		if i > 0 {
+			b = append(b, '.')
+		}
+		b = appendPadded(b, strconv.Itoa(n))
+	}
+	if v.Pre == "" {
+		b = append(b, '~')
+		return string(b), nil
+	}
+
+	// pad the number ending the tag, so that b10 sorts after b2 as lessPre
+	// has it
+	letters := strings.TrimRight(v.Pre, "0123456789")
+	b = append(b, '-')
+	b = append(b, letters...)
+	if n := v.Pre[len(letters):]; n != "" {
+		b = appendPadded(b, strings.TrimLeft(n, "0"))
+	}
+	return string(b), nil
+}
+
+// appendPadded appends the digits s zero padded to versionWidth.
+func appendPadded(b []byte, s string) []byte {
+	for j := len(s); j < versionWidth; j++ {
+		b = append(b, '0')
+	}
+	return append(b, s...)
+}
+
+// Scan implements sql.Scanner. It reads the text stored by Value, as well as
+// plain versions such as "45.0.2454". NULL scans as the zero Version.
+func (v *Version) Scan(src interface{}) error {
+	s, err := scanText(src, "Version")
+	if err != nil {
+		return err
+	}
+
+	var numbers, pre string
+	switch {
+	case strings.HasSuffix(s, "~"):
+		numbers = s[:len(s)-1]
+	case strings.Contains(s, "-"):
+		numbers, pre, _ = strings.Cut(s, "-")
+	default:
+		return v.UnmarshalText([]byte(s))
+	}
+
+	parts := strings.Split(numbers, ".")
+	if len(parts) != 4 {
+		return fmt.Errorf("uasurfer: invalid version %q", s)
+	}
+	var n [4]int
+	for i, p := range parts {
+		if n[i], err = strconv.Atoi(p); err != nil || n[i] < 0 {
+			return fmt.Errorf("uasurfer: invalid version %q", s)
+		}
+	}
+	*v = Version{Major: n[0], Minor: n[1], Patch: n[2], Build: n[3], Pre: unpadPre(pre)}
+	return nil
+}
+
+// unpadPre removes the padding Value adds to the number ending a tag. Leading
+// zeros the tag had itself are dropped too.
+func unpadPre(pre string) string {
+	letters := strings.TrimRight(pre, "0123456789")
+	n := pre[len(letters):]
+	if n == "" {
+		return pre
+	}
+	if n = strings.TrimLeft(n, "0"); n == "" {
+		n = "0"
+	}
+	return letters + n
+}
+
+// Value implements driver.Valuer. The UserAgent is stored as its JSON
+// encoding, which suits JSON columns in Postgres and SQLite as well as text.
+func (ua UserAgent) Value() (driver.Value, error) {
+	return string(ua.AppendJSON(make([]byte, 0, 192))), nil
+}
+
+// Scan implements sql.Scanner, reading the JSON stored by Value. NULL scans
+// as the zero UserAgent.
+func (ua *UserAgent) Scan(src interface{}) error {
+	s, err := scanText(src, "UserAgent")
+	if err != nil {
+		return err
+	}
+	ua.Reset()
+	if s == "" {
+		return nil
+	}
+	return ua.UnmarshalJSON([]byte(s))
+}
@@ -0,0 +1,123 @@
+package uasurfer
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func TestEnumValueScan(t *testing.T) {
+	v, err := BrowserChrome.Value()
+	if err != nil || v != "BrowserChrome" {
+		t.Errorf("Value: got %v, %v", v, err)
+	}
+
+	var b BrowserName
+	for _, src := range []interface{}{"BrowserChrome", []byte("BrowserChrome"), "chrome"} {
+		b = BrowserUnknown
+		if err := b.Scan(src); err != nil || b != BrowserChrome {
+			t.Errorf("Scan(%v): got %v, %v", src, b, err)
+		}
+	}
+	if err := b.Scan(nil); err != nil || b != BrowserUnknown {
+		t.Errorf("Scan(nil): got %v, %v", b, err)
+	}
+	if err := b.Scan(int64(1)); err == nil {
+		t.Error("Scan(int64): expected an error")
+	}
+	if err := b.Scan("Netscape"); err == nil {
+		t.Error("Scan: expected an error for an unknown browser")
+	}
+
+	var o OSName
+	if err := o.Scan("OSWindows"); err != nil || o != OSWindows {
+		t.Errorf("OSName.Scan: got %v, %v", o, err)
+	}
+	var p Platform
+	if err := p.Scan([]byte("PlatformMac")); err != nil || p != PlatformMac {
+		t.Errorf("Platform.Scan: got %v, %v", p, err)
+	}
+	var d DeviceType
+	if err := d.Scan("DevicePhone"); err != nil || d != DevicePhone {
+		t.Errorf("DeviceType.Scan: got %v, %v", d, err)
+	}
+}
+
+func TestVersionValueScan(t *testing.T) {
+	ordered := []Version{
+		{},
+		{Major: 9, Minor: 80},
+		{Major: 45, Minor: 0, Patch: 2454},
+		{Major: 120, Minor: 0, Patch: 6099, Build: 109},
+		{Major: 121, Pre: "a1"},
+		{Major: 121, Pre: "b"},
+		{Major: 121, Pre: "b2"},
+		{Major: 121, Pre: "b3"},
+		{Major: 121, Pre: "b10"},
+		{Major: 121, Pre: "beta"},
+		{Major: 121},
+	}
+
+	var values []string
+	for i, v := range ordered {
+		dv, err := v.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, dv.(string))
+
+		var scanned Version
+		if err := scanned.Scan(dv); err != nil || scanned != v {
+			t.Errorf("Scan(%v): got %+v, %v, wanted %+v", dv, scanned, err, ordered[i])
+		}
+	}
+	if !sort.StringsAreSorted(values) {
+		t.Errorf("stored versions don't sort in order: %q", values)
+	}
+	for i := 1; i < len(ordered); i++ {
+		if !ordered[i-1].Less(ordered[i]) {
+			t.Errorf("%+v is not less than %+v", ordered[i-1], ordered[i])
+		}
+	}
+
+	if v, _ := (Version{Major: 121, Pre: "b3"}).Value(); v != "00000121.00000000.00000000.00000000-b00000003" {
+		t.Errorf("Value: got %v", v)
+	}
+
+	var v Version
+	if err := v.Scan("45.0.2454"); err != nil || !v.Equal(Version{Major: 45, Patch: 2454}) {
+		t.Errorf("Scan plain version: got %+v, %v", v, err)
+	}
+	if err := v.Scan("1.2~"); err == nil {
+		t.Error("Scan: expected an error for a malformed version")
+	}
+}
+
+func TestUserAgentValueScan(t *testing.T) {
+	ua := Parse("Mozilla/5.0 (iPhone; CPU iPhone OS 8_0_2 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) Version/8.0 Mobile/12A405 Safari/600.1.4")
+
+	var valuer driver.Valuer = *ua
+	v, err := valuer.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scanned := UserAgent{DeviceType: DeviceTV}
+	if err := scanned.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if scanned.Browser.Name != BrowserSafari || !scanned.Browser.Version.Equal(ua.Browser.Version) ||
+		scanned.OS != (OS{PlatformiPhone, OSiOS, Version{Major: 8, Patch: 2, Raw: "8.0.2"}}) || scanned.DeviceType != DevicePhone {
+		t.Errorf("got %+v, wanted %+v", scanned, *ua)
+	}
+
+	if err := scanned.Scan(nil); err != nil || scanned != (UserAgent{}) {
+		t.Errorf("Scan(nil): got %+v, %v", scanned, err)
+	}
+
+	ua.Browser.Version.Pre = `"\`
+	if v, err := ua.Value(); err != nil || !json.Valid([]byte(v.(string))) {
+		t.Errorf("Value: got invalid JSON %v, %v", v, err)
+	}
+}
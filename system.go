@@ -1,6 +1,7 @@
 package uasurfer
 
 import (
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -11,6 +12,10 @@ var (
 )
 
 func (u *UserAgent) evalOS(ua string) bool {
+	if u.evalRegisteredOS(ua) {
+		return u.maybeBot()
+	}
+
 	s := strings.IndexRune(ua, '(')
 	e := strings.IndexRune(ua, ')')
 	if s > e {
@@ -45,6 +50,9 @@ func (u *UserAgent) evalOS(ua string) bool {
 	case strings.HasPrefix(specs, "ipad") || strings.HasPrefix(specs, "iphone") || strings.HasPrefix(specs, "ipod touch") || strings.HasPrefix(specs, "ipod"):
 		u.evaliOS(specs, agentPlatform)
 
+	case specs == "apple tv" || strings.HasPrefix(specs, "appletv"):
+		u.evaltvOS(ua, agentPlatform)
+
 	case specs == "macintosh":
 		u.evalMacintosh(ua)
 
@@ -59,6 +67,23 @@ func (u *UserAgent) evalOS(ua string) bool {
 		case strings.Contains(agentPlatform, "windows phone "):
 			u.evalWindowsPhone(agentPlatform)
 
+		// KaiOS, ahead of Android as JioPhones also claim it
+		case strings.Contains(ua, "kaios"):
+			u.OS.Platform = PlatformLinux
+			u.OS.Name = OSKaiOS
+			u.OS.Version.findVersionNumber(ua, "kaios/")
+
+		// Nokia Series 40, which does not always name itself
+		case strings.Contains(ua, "series40") || strings.Contains(ua, "s40ovibrowser") || (strings.HasPrefix(ua, "nokia") && strings.Contains(ua, "midp") && !isSmartphoneOS(ua)):
+			u.OS.Platform = PlatformUnknown
+			u.OS.Name = OSSeries40
+
+		// Other Java ME feature phones. Symbian and Android phones, and proxy
+		// browsers running on them, carry MIDP tokens too
+		case (strings.Contains(ua, "midp") || strings.Contains(ua, "j2me")) && !isSmartphoneOS(ua):
+			u.OS.Platform = PlatformUnknown
+			u.OS.Name = OSJ2ME
+
 		// Windows, Xbox
 		case strings.Contains(ua, "windows ") || strings.Contains(ua, "microsoft-cryptoapi"):
 			u.evalWindows(ua)
@@ -68,6 +93,20 @@ func (u *UserAgent) evalOS(ua string) bool {
 			u.OS.Platform = PlatformLinux
 			u.OS.Name = OSKindle
 
+		// Roku OS, a Linux derivative that does not say so
+		case strings.Contains(ua, "roku"):
+			u.OS.Platform = PlatformLinux
+			u.OS.Name = OSRokuOS
+			u.OS.Version.findVersionNumber(ua, "/dvp-")
+
+		// Apple TV, e.g. AppleTV6,2/11.1
+		case strings.HasPrefix(ua, "appletv") || strings.Contains(ua, "tvos"):
+			u.evaltvOS(ua, agentPlatform)
+
+		// OpenHarmony reports its device class in place of a platform, e.g. (Phone; OpenHarmony 4.1)
+		case strings.Contains(ua, "openharmony") || strings.Contains(ua, "harmonyos"):
+			u.evalHarmonyOS(ua)
+
 		// Linux (broader attempt)
 		case strings.Contains(ua, "linux"):
 			u.evalLinux(ua, agentPlatform)
@@ -104,6 +143,13 @@ func (u *UserAgent) evalOS(ua string) bool {
 	return u.maybeBot()
 }
 
+// isSmartphoneOS reports whether ua names Symbian, Android or Linux, which
+// run Java ME apps but are not feature phones.
+func isSmartphoneOS(ua string) bool {
+	return strings.Contains(ua, "symbian") || strings.Contains(ua, "symbos") ||
+		strings.Contains(ua, "android") || strings.Contains(ua, "adr ") || strings.Contains(ua, "linux")
+}
+
 // maybeBot checks if the UserAgent is a bot and sets
 // all bot related fields if it is
 func (u *UserAgent) maybeBot() bool {
@@ -121,6 +167,34 @@ func (u *UserAgent) maybeBot() bool {
 func (u *UserAgent) evalLinux(ua string, agentPlatform string) {
 
 	switch {
+	// Huawei, which keeps the Android token on HarmonyOS
+	case strings.Contains(ua, "openharmony") || strings.Contains(ua, "harmonyos"):
+		u.evalHarmonyOS(ua)
+
+	// Samsung TVs and watches
+	case strings.Contains(ua, "tizen"):
+		u.OS.Platform = PlatformLinux
+		u.OS.Name = OSTizen
+		_ = u.OS.Version.findVersionNumber(agentPlatform, "tizen ") || u.OS.Version.findVersionNumber(ua, "tizen/")
+
+	// LG TVs, which identify as Web0S
+	case strings.Contains(ua, "web0s") || strings.Contains(ua, "webos.tv") || (strings.Contains(ua, "webos") && strings.Contains(ua, "smarttv")):
+		u.OS.Platform = PlatformLinux
+		u.OS.Name = OSWebOSTV
+		u.OS.getWebOSTVVersion(ua)
+
+	// Hisense TVs
+	case strings.Contains(ua, "vidaa"):
+		u.OS.Platform = PlatformLinux
+		u.OS.Name = OSVIDAA
+		u.OS.Version.findVersionNumber(ua, "vidaa/")
+
+	// Fire TV, identified by its AFT model prefix
+	case strings.Contains(agentPlatform, "; aft"):
+		u.OS.Platform = PlatformLinux
+		u.OS.Name = OSFireOS
+		u.OS.getFireOSVersion(agentPlatform)
+
 	// Kindle Fire
 	case strings.Contains(ua, "kindle") || amazonFireFingerprint.MatchString(agentPlatform):
 		// get the version of Android if available, though we don't call this OSAndroid
@@ -128,12 +202,20 @@ func (u *UserAgent) evalLinux(ua string, agentPlatform string) {
 		u.OS.Name = OSKindle
 		u.OS.Version.findVersionNumber(agentPlatform, "android ")
 
+	// Android TV and Google TV
+	case strings.Contains(ua, "android tv") || strings.Contains(ua, "androidtv") || strings.Contains(ua, "googletv") || strings.Contains(ua, "google tv") ||
+		strings.Contains(ua, "bravia") || strings.Contains(ua, "chromecast") || strings.Contains(ua, "mibox"):
+		u.OS.Platform = PlatformLinux
+		u.OS.Name = OSAndroidTV
+		_ = u.OS.Version.findVersionNumber(agentPlatform, "android ") || u.OS.Version.findVersionNumber(agentPlatform, "googletv ")
+
 	// Android, Kindle Fire
-	case strings.Contains(ua, "android") || strings.Contains(ua, "googletv"):
+	case strings.Contains(ua, "android"):
 		// Android
 		u.OS.Platform = PlatformLinux
 		u.OS.Name = OSAndroid
-		u.OS.Version.findVersionNumber(agentPlatform, "android ")
+		// UC Browser abbreviates it, e.g. (MIDP-2.0; U; Adr 9; en-US)
+		_ = u.OS.Version.findVersionNumber(agentPlatform, "android ") || u.OS.Version.findVersionNumber(agentPlatform, "adr ")
 
 	// ChromeOS
 	case strings.Contains(ua, "cros"):
@@ -185,6 +267,35 @@ func (u *UserAgent) evaliOS(uaPlatform string, agentPlatform string) {
 	}
 }
 
+// evalHarmonyOS returns the `Platform`, `OSName` and Version of Huawei
+// HarmonyOS and OpenHarmony UAs.
+func (u *UserAgent) evalHarmonyOS(ua string) {
+	u.OS.Platform = PlatformLinux
+	if strings.Contains(ua, "openharmony") {
+		u.OS.Name = OSOpenHarmony
+		u.OS.Version.findVersionNumber(ua, "openharmony ")
+		return
+	}
+	u.OS.Name = OSHarmonyOS
+	_ = u.OS.Version.findVersionNumber(ua, "harmonyos ") || u.OS.Version.findVersionNumber(ua, "harmonyos/")
+}
+
+// evaltvOS returns the `Platform`, `OSName` and Version of Apple TV UAs.
+func (u *UserAgent) evaltvOS(ua string, agentPlatform string) {
+	u.OS.Platform = PlatformAppleTV
+	u.OS.Name = OStvOS
+
+	switch {
+	case strings.Contains(agentPlatform, "cpu os "):
+		u.OS.getiOSVersion(agentPlatform)
+	case strings.HasPrefix(ua, "appletv"):
+		// model identifier followed by the tvOS version, e.g. appletv6,2/11.1
+		u.OS.Version.findVersionNumber(ua, "/")
+	default:
+		_ = u.OS.Version.findVersionNumber(ua, "tvos/") || u.OS.Version.findVersionNumber(ua, "tvos ")
+	}
+}
+
 func (u *UserAgent) evalWindowsPhone(agentPlatform string) {
 	u.OS.Platform = PlatformWindowsPhone
 
@@ -265,6 +376,59 @@ func (o *OS) getiOSVersion(uaPlatformGroup string) {
 	o.Version.parse(uaPlatformGroup)
 }
 
+// getWebOSTVVersion infers the webOS TV release from the Chromium version of
+// its web engine, as LG TVs do not report their OS version.
+// See https://webostv.developer.lge.com/develop/specifications/web-api-and-web-engine
+func (o *OS) getWebOSTVVersion(ua string) {
+	var chrome Version
+	if !chrome.findVersionNumber(ua, "chrome/") {
+		return
+	}
+
+	switch {
+	case chrome.Major >= 120:
+		o.Version.Major = 25
+	case chrome.Major >= 108:
+		o.Version.Major = 24
+	case chrome.Major >= 94:
+		o.Version.Major = 23
+	case chrome.Major >= 87:
+		o.Version.Major = 22
+	case chrome.Major >= 79:
+		o.Version.Major = 6
+	case chrome.Major >= 68:
+		o.Version.Major = 5
+	case chrome.Major >= 53:
+		o.Version.Major = 4
+	case chrome.Major >= 38:
+		o.Version.Major = 3
+	}
+}
+
+// getFireOSVersion infers the Fire OS release from the Android version it
+// is based on. See https://developer.amazon.com/docs/fire-tv/fire-os-overview.html
+func (o *OS) getFireOSVersion(agentPlatform string) {
+	var android Version
+	if !android.findVersionNumber(agentPlatform, "android ") {
+		return
+	}
+
+	switch {
+	case android.Major >= 11:
+		o.Version.Major = 8
+	case android.Major >= 9:
+		o.Version.Major = 7
+	case android.Major >= 7:
+		o.Version.Major = 6
+	case android.Major >= 5:
+		o.Version.Major = 5
+	case android.Major == 4 && android.Minor >= 4:
+		o.Version.Major = 4
+	case android.Major == 4 && android.Minor >= 2:
+		o.Version.Major = 3
+	}
+}
+
 // strToInt simply accepts a string and returns a `int`,
 // with '0' being default.
 func strToInt(str string) int {
@@ -272,59 +436,62 @@ func strToInt(str string) int {
 	return i
 }
 
-// strToVer accepts a string and returns a Version,
-// with {0, 0, 0} being default.
+// parse reads a version from the start of str: up to four numbers separated
+// by dots (or underscores, as in iOS versions) and a suffix such as b3 that
+// directly follows them. It returns false if str doesn't start with a digit.
 func (v *Version) parse(str string) bool {
 	if len(str) == 0 || str[0] < '0' || str[0] > '9' {
 		return false
 	}
-	for i := 0; i < 3; i++ {
-		empty := true
-		val := 0
-		l := len(str) - 1
-
-		for k, c := range str {
-			if c >= '0' && c <= '9' {
-				if empty {
-					val = int(c) - 48
-					empty = false
-					if k == l {
-						str = str[:0]
-					}
-					continue
-				}
-
-				if val == 0 {
-					if c == '0' {
-						if k == l {
-							str = str[:0]
-						}
-						continue
-					}
-					str = str[k:]
-					break
-				}
-
-				val = 10*val + int(c) - 48
-				if k == l {
-					str = str[:0]
-				}
-				continue
+	*v = Version{}
+
+	i := 0
+	for n := 0; n < 4; n++ {
+		val, start := 0, i
+		for ; i < len(str) && str[i] >= '0' && str[i] <= '9'; i++ {
+			// a leading zero ends the number, so that IE's 5.01 reads as 5.0.1
+			if val == 0 && i > start && str[i] != '0' {
+				break
+			}
+			// cap numbers at math.MaxInt32, so that they fit anywhere an
+			// int does and Pack stores them without loss
+			if d := int(str[i] - '0'); val <= (math.MaxInt32-d)/10 {
+				val = 10*val + d
+			} else {
+				val = math.MaxInt32
 			}
-			str = str[k+1:]
-			break
 		}
 
-		switch i {
+		switch n {
 		case 0:
 			v.Major = val
-
 		case 1:
 			v.Minor = val
-
 		case 2:
 			v.Patch = val
+		case 3:
+			v.Build = val
+		}
+
+		if n == 3 || i >= len(str) {
+			break
+		}
+		if str[i] >= '1' && str[i] <= '9' {
+			continue
+		}
+		if i+1 >= len(str) || (str[i] != '.' && str[i] != '_') || str[i+1] < '0' || str[i+1] > '9' {
+			break
+		}
+		i++
+	}
+
+	if i < len(str) && str[i] >= 'a' && str[i] <= 'z' {
+		j := i
+		for ; j < len(str) && (str[j] >= 'a' && str[j] <= 'z' || str[j] >= '0' && str[j] <= '9'); j++ {
 		}
+		v.Pre = str[i:j]
+		i = j
 	}
+	v.Raw = str[:i]
 	return true
 }
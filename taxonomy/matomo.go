@@ -0,0 +1,37 @@
+package taxonomy
+
+import "github.com/avct/uasurfer"
+
+// ToMatomo returns the Matomo Device Detector device type of ua, such as
+// "smartphone" or "tv", or "" if it is unknown. uasurfer doesn't tell apart
+// phablets, cameras, car browsers, smart displays, smart speakers or
+// peripherals, so those types aren't returned; iPods are reported as
+// portable media players. Bots have no device type, as Device Detector
+// reports them apart.
+//
+// See https://github.com/matomo-org/device-detector
+func ToMatomo(ua *uasurfer.UserAgent) string {
+	if ua.IsBot() {
+		return ""
+	}
+	if ua.OS.Platform == uasurfer.PlatformiPod {
+		return "portable media player"
+	}
+	switch ua.DeviceType {
+	case uasurfer.DeviceComputer:
+		return "desktop"
+	case uasurfer.DevicePhone:
+		return "smartphone"
+	case uasurfer.DeviceFeaturePhone:
+		return "feature phone"
+	case uasurfer.DeviceTablet:
+		return "tablet"
+	case uasurfer.DeviceConsole:
+		return "console"
+	case uasurfer.DeviceTV:
+		return "tv"
+	case uasurfer.DeviceWearable:
+		return "wearable"
+	}
+	return ""
+}
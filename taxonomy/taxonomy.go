@@ -0,0 +1,97 @@
+// Package taxonomy translates a parsed uasurfer.UserAgent into the names
+// other analytics tools report, so that reports can be reconciled with
+// Google Analytics 4, Matomo and pipelines built on ua-parser.
+//
+// Each tool names browsers, OSes and devices its own way, and some tell
+// apart what uasurfer doesn't, or the other way round. The mappings follow
+// what each tool reports for the same user agent where it can be told, and
+// fall back to the uasurfer name without its prefix otherwise.
+package taxonomy
+
+import "github.com/avct/uasurfer"
+
+// GA4 holds the Google Analytics 4 device dimensions of a user agent.
+type GA4 struct {
+	DeviceCategory  string // "desktop", "mobile", "tablet" or "smart tv"
+	Browser         string
+	OperatingSystem string
+}
+
+// notSet is what Google Analytics reports for an unknown dimension.
+const notSet = "(not set)"
+
+// ga4Browsers holds the Google Analytics browser names that differ from the
+// constant without its prefix.
+var ga4Browsers = map[uasurfer.BrowserName]string{
+	uasurfer.BrowserSamsung:   "Samsung Internet",
+	uasurfer.BrowserUCBrowser: "UC Browser",
+	uasurfer.BrowserUCMini:    "UC Browser",
+	uasurfer.BrowserSilk:      "Amazon Silk",
+	uasurfer.BrowserYandex:    "YaBrowser",
+	uasurfer.BrowserOperaGX:   "Opera",
+	uasurfer.BrowserOperaMini: "Opera Mini",
+	uasurfer.BrowserAndroid:   "Android Browser",
+	uasurfer.BrowserWhale:     "Whale Browser",
+	uasurfer.BrowserCocCoc:    "Coc Coc",
+	uasurfer.BrowserMIUI:      "Mi Browser",
+	uasurfer.BrowserHuawei:    "Huawei Browser",
+}
+
+// ga4OSes holds the Google Analytics operating system names that differ from
+// the constant without its prefix.
+var ga4OSes = map[uasurfer.OSName]string{
+	uasurfer.OSMacOSX:       "Macintosh",
+	uasurfer.OSChromeOS:     "Chrome OS",
+	uasurfer.OSWindowsPhone: "Windows Phone",
+	uasurfer.OSBlackberry:   "BlackBerry",
+	uasurfer.OSPlaystation:  "PlayStation",
+	uasurfer.OSWebOS:        "webOS",
+	uasurfer.OSWebOSTV:      "webOS",
+	uasurfer.OSFireOS:       "Android",
+	uasurfer.OSAndroidTV:    "Android",
+	uasurfer.OSKindle:       "Android",
+}
+
+// ToGA4 returns the Google Analytics 4 deviceCategory, browser and
+// operatingSystem of ua. Unknown dimensions are "(not set)". Analytics
+// counts consoles as desktops and wearables as mobiles.
+func ToGA4(ua *uasurfer.UserAgent) GA4 {
+	ga := GA4{DeviceCategory: notSet, Browser: notSet, OperatingSystem: notSet}
+
+	switch ua.DeviceType {
+	case uasurfer.DeviceComputer, uasurfer.DeviceConsole:
+		ga.DeviceCategory = "desktop"
+	case uasurfer.DevicePhone, uasurfer.DeviceFeaturePhone, uasurfer.DeviceWearable:
+		ga.DeviceCategory = "mobile"
+	case uasurfer.DeviceTablet:
+		ga.DeviceCategory = "tablet"
+	case uasurfer.DeviceTV:
+		ga.DeviceCategory = "smart tv"
+	}
+
+	switch name := ua.Browser.Name; {
+	case name == uasurfer.BrowserUnknown:
+	case ua.Browser.IsEdge():
+		ga.Browser = "Edge"
+	case name == uasurfer.BrowserIE:
+		ga.Browser = "Internet Explorer"
+	default:
+		ga.Browser = lookup(ga4Browsers, name)
+	}
+
+	if ua.OS.Name != uasurfer.OSUnknown {
+		ga.OperatingSystem = lookup(ga4OSes, ua.OS.Name)
+	}
+	return ga
+}
+
+// lookup returns the name of v in names, or its constant without the prefix.
+func lookup[T interface {
+	comparable
+	StringTrimPrefix() string
+}](names map[T]string, v T) string {
+	if s, ok := names[v]; ok {
+		return s
+	}
+	return v.StringTrimPrefix()
+}
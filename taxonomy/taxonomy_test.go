@@ -0,0 +1,93 @@
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/avct/uasurfer"
+)
+
+var testUAs = []struct {
+	ua       string
+	ga4      GA4
+	matomo   string
+	uaParser UAParser
+}{
+	{
+		"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Mobile Safari/537.36",
+		GA4{"mobile", "Chrome", "Android"},
+		"smartphone",
+		UAParser{"Chrome Mobile", "Android", "SM-G973F"},
+	},
+	{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+		GA4{"desktop", "Edge", "Windows"},
+		"desktop",
+		UAParser{"Edge", "Windows", "Other"},
+	},
+	{
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+		GA4{"desktop", "Safari", "Macintosh"},
+		"desktop",
+		UAParser{"Safari", "Mac OS X", "Mac"},
+	},
+	{
+		"Mozilla/5.0 (iPad; CPU OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+		GA4{"tablet", "Safari", "iOS"},
+		"tablet",
+		UAParser{"Mobile Safari", "iOS", "iPad"},
+	},
+	{
+		"Mozilla/5.0 (iPod touch; CPU iPhone OS 12_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.1.2 Mobile/15E148 Safari/604.1",
+		GA4{"tablet", "Safari", "iOS"},
+		"portable media player",
+		UAParser{"Mobile Safari", "iOS", "iPod"},
+	},
+	{
+		"Mozilla/5.0 (SMART-TV; Linux; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/4.0 Chrome/76.0.3809.146 TV Safari/537.36",
+		GA4{"smart tv", "Samsung Internet", "Tizen"},
+		"tv",
+		UAParser{"Samsung Internet", "Tizen", "Other"},
+	},
+	{
+		"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko",
+		GA4{"desktop", "Internet Explorer", "Windows"},
+		"desktop",
+		UAParser{"IE", "Windows", "Other"},
+	},
+	{
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		GA4{"desktop", "GoogleBot", "Bot"},
+		"",
+		UAParser{"Googlebot", "Other", "Spider"},
+	},
+	{
+		"",
+		GA4{"(not set)", "(not set)", "(not set)"},
+		"",
+		UAParser{"Other", "Other", "Other"},
+	},
+}
+
+func TestToGA4(t *testing.T) {
+	for _, tc := range testUAs {
+		if got := ToGA4(uasurfer.Parse(tc.ua)); got != tc.ga4 {
+			t.Errorf("UA: %s\ngot:      %+v\nexpected: %+v", tc.ua, got, tc.ga4)
+		}
+	}
+}
+
+func TestToMatomo(t *testing.T) {
+	for _, tc := range testUAs {
+		if got := ToMatomo(uasurfer.Parse(tc.ua)); got != tc.matomo {
+			t.Errorf("UA: %s\ngot:      %q\nexpected: %q", tc.ua, got, tc.matomo)
+		}
+	}
+}
+
+func TestToUAParser(t *testing.T) {
+	for _, tc := range testUAs {
+		if got := ToUAParser(uasurfer.Parse(tc.ua), tc.ua); got != tc.uaParser {
+			t.Errorf("UA: %s\ngot:      %+v\nexpected: %+v", tc.ua, got, tc.uaParser)
+		}
+	}
+}
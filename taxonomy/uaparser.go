@@ -0,0 +1,104 @@
+package taxonomy
+
+import (
+	"github.com/avct/uasurfer"
+	"github.com/avct/uasurfer/internal/device"
+)
+
+// UAParser holds the ua-parser families of a user agent.
+type UAParser struct {
+	UserAgent string
+	OS        string
+	Device    string
+}
+
+// other is what ua-parser reports for an unknown family.
+const other = "Other"
+
+// uaParserBrowsers holds the ua-parser user agent families that differ from
+// the constant without its prefix.
+var uaParserBrowsers = map[uasurfer.BrowserName]string{
+	uasurfer.BrowserIE:            "IE",
+	uasurfer.BrowserSamsung:       "Samsung Internet",
+	uasurfer.BrowserUCBrowser:     "UC Browser",
+	uasurfer.BrowserYandex:        "Yandex Browser",
+	uasurfer.BrowserOperaMini:     "Opera Mini",
+	uasurfer.BrowserOperaGX:       "Opera GX",
+	uasurfer.BrowserCocCoc:        "Coc Coc",
+	uasurfer.BrowserMIUI:          "MiuiBrowser",
+	uasurfer.BrowserHuawei:        "HuaweiBrowser",
+	uasurfer.BrowserPaleMoon:      "Pale Moon",
+	uasurfer.BrowserFirefoxFocus:  "Firefox Focus",
+	uasurfer.BrowserGoogleBot:     "Googlebot",
+	uasurfer.BrowserBingBot:       "bingbot",
+	uasurfer.BrowserAppleBot:      "Applebot",
+	uasurfer.BrowserYandexBot:     "YandexBot",
+	uasurfer.BrowserBaiduBot:      "Baiduspider",
+	uasurfer.BrowserDuckDuckGoBot: "DuckDuckBot",
+	uasurfer.BrowserFacebookBot:   "FacebookBot",
+	uasurfer.BrowserTwitterBot:    "Twitterbot",
+	uasurfer.BrowserLinkedInBot:   "LinkedInBot",
+}
+
+// uaParserOSes holds the ua-parser OS families that differ from the constant
+// without its prefix.
+var uaParserOSes = map[uasurfer.OSName]string{
+	uasurfer.OSMacOSX:       "Mac OS X",
+	uasurfer.OSChromeOS:     "Chrome OS",
+	uasurfer.OSWindowsPhone: "Windows Phone",
+	uasurfer.OSBlackberry:   "BlackBerry OS",
+	uasurfer.OSWebOS:        "webOS",
+	uasurfer.OSWebOSTV:      "webOS",
+	uasurfer.OSPlaystation:  "PlayStation",
+	uasurfer.OSRokuOS:       "Roku",
+	uasurfer.OSAndroidTV:    "Android",
+	uasurfer.OSFireOS:       "Android",
+	uasurfer.OSKindle:       "Android",
+	uasurfer.OSBot:          other,
+}
+
+// ToUAParser returns the ua-parser user agent, OS and device families of ua.
+// The raw user agent it was parsed from is read for the device model, which
+// ua-parser reports as the device family. Pass an empty string if it isn't
+// available. Unknown families are "Other".
+//
+// See https://github.com/ua-parser/uap-core
+func ToUAParser(ua *uasurfer.UserAgent, raw string) UAParser {
+	p := UAParser{UserAgent: other, OS: other, Device: other}
+
+	mobile := ua.DeviceType == uasurfer.DevicePhone || ua.DeviceType == uasurfer.DeviceTablet
+	switch name := ua.Browser.Name; {
+	case name == uasurfer.BrowserUnknown:
+	case ua.Browser.IsEdge():
+		p.UserAgent = "Edge"
+		if mobile {
+			p.UserAgent = "Edge Mobile"
+		}
+	case name == uasurfer.BrowserChrome && mobile:
+		p.UserAgent = "Chrome Mobile"
+	case name == uasurfer.BrowserFirefox && mobile:
+		p.UserAgent = "Firefox Mobile"
+	case name == uasurfer.BrowserSafari && ua.OS.Name == uasurfer.OSiOS:
+		p.UserAgent = "Mobile Safari"
+	default:
+		p.UserAgent = lookup(uaParserBrowsers, name)
+	}
+
+	if ua.OS.Name != uasurfer.OSUnknown {
+		p.OS = lookup(uaParserOSes, ua.OS.Name)
+	}
+
+	switch {
+	case ua.IsBot():
+		p.Device = "Spider"
+	case ua.OS.Platform == uasurfer.PlatformMac:
+		p.Device = "Mac"
+	case ua.OS.Platform == uasurfer.PlatformiPhone, ua.OS.Platform == uasurfer.PlatformiPad, ua.OS.Platform == uasurfer.PlatformiPod:
+		p.Device = ua.OS.Platform.StringTrimPrefix()
+	default:
+		if d, ok := device.Parse(raw); ok {
+			p.Device = d.Model
+		}
+	}
+	return p
+}
@@ -7,7 +7,11 @@ package uasurfer
 
 import "strings"
 
-//go:generate stringer -type=DeviceType,BrowserName,OSName,Platform -output=const_string.go
+//go:generate stringer -type=DeviceType,BrowserName,BrowserFamily,Channel,OSName,Platform -output=const_string.go
+// The String methods of BrowserName and OSName also name registered values,
+// see registry.go, and wrap the generated ones.
+//go:generate sed -i.orig -e "s/^func (i BrowserName) String/func (i BrowserName) constString/" -e "s/^func (i OSName) String/func (i OSName) constString/" const_string.go
+//go:generate rm const_string.go.orig
 
 // DeviceType (int) returns a constant.
 type DeviceType int
@@ -22,6 +26,7 @@ const (
 	DeviceConsole
 	DeviceWearable
 	DeviceTV
+	DeviceFeaturePhone
 )
 
 // StringTrimPrefix is like String() but trims the "Device" prefix
@@ -33,7 +38,8 @@ func (d DeviceType) StringTrimPrefix() string {
 type BrowserName int
 
 // A complete list of supported web browsers in the
-// form of constants.
+// form of constants. New browsers are added at the end,
+// so that values don't change, and equal their ID().
 const (
 	BrowserUnknown BrowserName = iota
 	BrowserChrome
@@ -69,6 +75,49 @@ const (
 	BrowserYandexBot
 	BrowserCocCocBot
 	BrowserYahooBot // Bot list ends here
+	BrowserHuawei
+	BrowserArkWeb
+	BrowserVivaldi
+	BrowserWhale
+	BrowserOperaGX
+	BrowserBrave
+	BrowserArc
+	BrowserDuckDuckGo
+	BrowserEcosia
+	BrowserIceweasel
+	BrowserSeaMonkey
+	BrowserIceCat
+	BrowserWaterfox
+	BrowserLibreWolf
+	BrowserPaleMoon
+	BrowserBasilisk
+	BrowserKMeleon
+	BrowserFirefoxFocus
+	BrowserBaidu
+	Browser360
+	BrowserQuark
+	BrowserMIUI
+	BrowserVivo
+	BrowserHeyTap
+	BrowserLiebao
+	Browser2345
+	BrowserOperaMini // Proxy browser list begins here
+	BrowserPuffin
+	BrowserUCMini         // Proxy browser list ends here
+	BrowserAppleCoreMedia // Media player list begins here
+	BrowserExoPlayer
+	BrowserStagefright
+	BrowserVLC
+	BrowserFFmpeg
+	BrowserRoku
+	BrowserOvercast
+	BrowserPocketCasts
+	BrowseriTunes  // Media player list ends here
+	BrowserOutlook // Email client list begins here
+	BrowserThunderbird
+	BrowserAppleMail
+	BrowserGoogleImageProxy // Email proxy list begins here
+	BrowserYahooMailProxy   // Email client and proxy lists end here
 )
 
 // StringTrimPrefix is like String() but trims the "Browser" prefix
@@ -76,6 +125,49 @@ func (b BrowserName) StringTrimPrefix() string {
 	return strings.TrimPrefix(b.String(), "Browser")
 }
 
+// BrowserFamily (int) returns a constant.
+type BrowserFamily int
+
+// A complete list of browser families in the form of constants.
+// A family groups browsers sharing a layout engine, such as
+// Chrome and the many browsers built on Chromium.
+const (
+	FamilyUnknown BrowserFamily = iota
+	FamilyChromium
+	FamilyWebKit
+	FamilyGecko // Firefox and its forks, including Goanna based Pale Moon and Basilisk
+	FamilyTrident
+	FamilyPresto
+	FamilyEdgeHTML // Edge before it moved to Chromium
+)
+
+// StringTrimPrefix is like String() but trims the "Family" prefix
+func (f BrowserFamily) StringTrimPrefix() string {
+	return strings.TrimPrefix(f.String(), "Family")
+}
+
+// Channel (int) returns a constant.
+type Channel int
+
+// A complete list of browser release channels in the form of constants.
+// Edge Insider builds use the Beta, Dev and Canary channels like Chrome, and
+// Firefox Developer Edition is reported as Beta, which it is built from.
+const (
+	ChannelUnknown Channel = iota
+	ChannelStable
+	ChannelBeta
+	ChannelDev
+	ChannelCanary
+	ChannelNightly
+	ChannelESR
+	ChannelTechnologyPreview
+)
+
+// StringTrimPrefix is like String() but trims the "Channel" prefix
+func (c Channel) StringTrimPrefix() string {
+	return strings.TrimPrefix(c.String(), "Channel")
+}
+
 // OSName (int) returns a constant.
 type OSName int
 
@@ -99,6 +191,18 @@ const (
 	OSXbox
 	OSNintendo
 	OSBot
+	OSTizen
+	OSWebOSTV
+	OSRokuOS
+	OStvOS
+	OSFireOS
+	OSAndroidTV
+	OSVIDAA
+	OSHarmonyOS
+	OSOpenHarmony
+	OSKaiOS
+	OSSeries40
+	OSJ2ME
 )
 
 // StringTrimPrefix is like String() but trims the "OS" prefix
@@ -127,6 +231,7 @@ const (
 	PlatformXbox
 	PlatformNintendo
 	PlatformBot
+	PlatformAppleTV
 )
 
 // StringTrimPrefix is like String() but trims the "Platform" prefix
@@ -134,32 +239,6 @@ func (p Platform) StringTrimPrefix() string {
 	return strings.TrimPrefix(p.String(), "Platform")
 }
 
-type Version struct {
-	Major int
-	Minor int
-	Patch int
-}
-
-func (v Version) Less(c Version) bool {
-	if v.Major < c.Major {
-		return true
-	}
-
-	if v.Major > c.Major {
-		return false
-	}
-
-	if v.Minor < c.Minor {
-		return true
-	}
-
-	if v.Minor > c.Minor {
-		return false
-	}
-
-	return v.Patch < c.Patch
-}
-
 type UserAgent struct {
 	Browser    Browser
 	OS         OS
@@ -169,6 +248,18 @@ type UserAgent struct {
 type Browser struct {
 	Name    BrowserName
 	Version Version
+	// ServerRendered is true when pages are rendered on the vendor's servers,
+	// as by Opera Mini, Puffin, UC Mini and Silk in accelerated mode. Scripts
+	// then run away from the device, and requests arrive from the vendor's IPs.
+	ServerRendered bool
+	// Channel is the release channel, where the browser tells it apart. See
+	// EstimateChannel for other browsers.
+	Channel Channel
+	// Engine is the layout engine of browsers that ship on more than one, as
+	// Firefox Focus does on Gecko and on the Android web view, and Maxthon on
+	// Trident and Chromium, and FamilyUnknown for others. Family reports it
+	// where it is set.
+	Engine BrowserFamily
 }
 
 type OS struct {
@@ -186,7 +277,7 @@ func (ua *UserAgent) Reset() {
 
 // IsBot returns true if the UserAgent represent a bot
 func (ua *UserAgent) IsBot() bool {
-	if ua.Browser.Name >= BrowserBot && ua.Browser.Name <= BrowserYahooBot {
+	if ua.Browser.Name.is(flagBot) {
 		return true
 	}
 	if ua.OS.Name == OSBot {
@@ -198,6 +289,26 @@ func (ua *UserAgent) IsBot() bool {
 	return false
 }
 
+// IsMediaPlayer returns true if the UserAgent represents a media player or
+// podcast app fetching audio or video, rather than a web browser.
+func (ua *UserAgent) IsMediaPlayer() bool {
+	return ua.Browser.Name.is(flagMediaPlayer)
+}
+
+// IsEmailClient returns true if the UserAgent represents an email client,
+// or a proxy fetching remote content on behalf of one.
+func (ua *UserAgent) IsEmailClient() bool {
+	return ua.Browser.Name.is(flagEmailClient)
+}
+
+// IsEmailProxy returns true if the UserAgent represents a mail service
+// prefetching or caching remote content, such as tracking pixels, rather
+// than a person opening the message. Apple Mail Privacy Protection can't be
+// told from its user agent, and is not reported as a proxy.
+func (ua *UserAgent) IsEmailProxy() bool {
+	return ua.Browser.Name.is(flagEmailProxy)
+}
+
 // Parse accepts a raw user agent (string) and returns the UserAgent.
 func Parse(ua string) *UserAgent {
 	dest := new(UserAgent)
@@ -212,8 +323,8 @@ func ParseUserAgent(ua string, dest *UserAgent) {
 	parse(ua, dest)
 }
 
-func parse(ua string, dest *UserAgent) {
-	ua = normalise(ua)
+func parse(orig string, dest *UserAgent) {
+	ua := normalise(orig)
 	switch {
 	case len(ua) == 0:
 		dest.OS.Platform = PlatformUnknown
@@ -226,8 +337,11 @@ func parse(ua string, dest *UserAgent) {
 	case dest.evalBrowserName(ua):
 	default:
 		dest.evalBrowserVersion(ua)
+		dest.evalChannel(ua)
 		dest.evalDevice(ua)
 	}
+	dest.Browser.Version.restoreRaw(ua, orig)
+	dest.OS.Version.restoreRaw(ua, orig)
 }
 
 // normalise normalises the user supplied agent string so that
@@ -25,56 +25,56 @@ var testUAVars = []struct {
 	// iPhone
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 7_0 like Mac OS X) AppleWebKit/546.10 (KHTML, like Gecko) Version/6.0 Mobile/7E18WD Safari/8536.25",
 		UserAgent{
-			Browser{BrowserSafari, Version{6, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{7, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 6, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 8_0_2 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) Version/8.0 Mobile/12A405 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 0, 2}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 0, Patch: 2}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPhone10,3; CPU iPhone OS 8_0_2 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) Version/8.0 Mobile/12A405 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 0, 2}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 0, Patch: 2}}, DevicePhone}},
 
 	// iPad
 	{"Mozilla/5.0(iPad; U; CPU iPhone OS 3_2 like Mac OS X; en-us) AppleWebKit/531.21.10 (KHTML, like Gecko) Version/4.0.4 Mobile/7B314 Safari/531.21.10",
 		UserAgent{
-			Browser{BrowserSafari, Version{4, 0, 4}}, OS{PlatformiPad, OSiOS, Version{3, 2, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 4, Minor: 0, Patch: 4}}, OS{PlatformiPad, OSiOS, Version{Major: 3, Minor: 2, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 9_0 like Mac OS X) AppleWebKit/601.1.17 (KHTML, like Gecko) Version/8.0 Mobile/13A175 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 0}}, OS{PlatformiPad, OSiOS, Version{9, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 9, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_0 like Mac OS X) AppleWebKit/602.1.32 (KHTML, like Gecko) Version/10.0 Mobile/14A5261v Safari/602.1",
 		UserAgent{
-			Browser{BrowserSafari, Version{10, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{10, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// Chrome
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.130 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{43, 0, 2357}}, OS{PlatformMac, OSMacOSX, Version{10, 10, 4}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 43, Minor: 0, Patch: 2357}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 10, Patch: 4}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (iPhone; U; CPU iPhone OS 5_1_1 like Mac OS X; en) AppleWebKit/534.46.0 (KHTML, like Gecko) CriOS/19.0.1084.60 Mobile/9B206 Safari/534.48.3",
 		UserAgent{
-			Browser{BrowserChrome, Version{19, 0, 1084}}, OS{PlatformiPhone, OSiOS, Version{5, 1, 1}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 19, Minor: 0, Patch: 1084}}, OS{PlatformiPhone, OSiOS, Version{Major: 5, Minor: 1, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; Android 6.0; Nexus 5X Build/MDB08L) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/46.0.2490.76 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{46, 0, 2490}}, OS{PlatformLinux, OSAndroid, Version{6, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 46, Minor: 0, Patch: 2490}}, OS{PlatformLinux, OSAndroid, Version{Major: 6, Minor: 0, Patch: 0}}, DevicePhone}},
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4_1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{124, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{14, 4, 1}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 124, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 14, Minor: 4, Patch: 1}}, DeviceComputer}},
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 11_1_0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36", // macOS Big Sur
 		UserAgent{
-			Browser{BrowserChrome, Version{87, 0, 4280}}, OS{PlatformMac, OSMacOSX, Version{11, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 87, Minor: 0, Patch: 4280}}, OS{PlatformMac, OSMacOSX, Version{Major: 11, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	// Chromium (Chrome)
 	{"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/535.19 (KHTML, like Gecko) Ubuntu/11.10 Chromium/18.0.1025.142 Chrome/18.0.1025.142 Safari/535.19",
 		UserAgent{
-			Browser{BrowserChrome, Version{18, 0, 1025}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 18, Minor: 0, Patch: 1025}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_11_0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/45.0.2454.85 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{45, 0, 2454}}, OS{PlatformMac, OSMacOSX, Version{10, 11, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 45, Minor: 0, Patch: 2454}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 11, Patch: 0}}, DeviceComputer}},
 
 	//TODO: refactor "getVersion()" to handle this device/chrome version douchebaggery
 	// {"Mozilla/5.0 (Linux; Android 4.4.2; en-gb; SAMSUNG SM-G800F Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.6 Chrome/28.0.1500.94 Mobile Safari/537.36",
@@ -84,220 +84,252 @@ var testUAVars = []struct {
 	// Safari
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_4) AppleWebKit/600.7.12 (KHTML, like Gecko) Version/8.0.7 Safari/600.7.12",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 7}}, OS{PlatformMac, OSMacOSX, Version{10, 10, 4}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 7}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 10, Patch: 4}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10_5_5; en-us) AppleWebKit/525.26.2 (KHTML, like Gecko) Version/3.2 Safari/525.26.12",
 		UserAgent{
-			Browser{BrowserSafari, Version{3, 2, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 5, 5}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 3, Minor: 2, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 5, Patch: 5}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12) AppleWebKit/602.1.32 (KHTML, like Gecko) Version/10.0 Safari/602.1.32", // macOS Sierra dev beta
 		UserAgent{
-			Browser{BrowserSafari, Version{10, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 12, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 12, Patch: 0}}, DeviceComputer}},
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4.1 Mobile/15E148 Safari/604.1",
 		UserAgent{
-			Browser{BrowserSafari, Version{17, 4, 1}}, OS{PlatformiPhone, OSiOS, Version{17, 4, 1}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 17, Minor: 4, Patch: 1}}, OS{PlatformiPhone, OSiOS, Version{Major: 17, Minor: 4, Patch: 1}}, DevicePhone}},
 
 	// Firefox
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 8_3 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) FxiOS/1.0 Mobile/12F69 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserFirefox, Version{1, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 3, 0}}, DevicePhone}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 3, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Android 4.4; Tablet; rv:41.0) Gecko/41.0 Firefox/41.0",
 		UserAgent{
-			Browser{BrowserFirefox, Version{41, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 0}}, DeviceTablet}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 41, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Android; Mobile; rv:40.0) Gecko/40.0 Firefox/40.0",
 		UserAgent{
-			Browser{BrowserFirefox, Version{40, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 40, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:38.0) Gecko/20100101 Firefox/38.0",
 		UserAgent{
-			Browser{BrowserFirefox, Version{38, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 38, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0",
 		UserAgent{
-			Browser{BrowserFirefox, Version{125, 0, 0}}, OS{PlatformWindows, OSWindows, Version{10, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 125, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) FxiOS/125.0 Mobile/15E148 Safari/605.1.15",
 		UserAgent{
-			Browser{BrowserFirefox, Version{125, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{17, 4, 1}}, DevicePhone}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 125, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 17, Minor: 4, Patch: 1}}, DevicePhone}},
 
 	// Silk
 	{"Mozilla/5.0 (Linux; U; Android 4.4.3; de-de; KFTHWI Build/KTU84M) AppleWebKit/537.36 (KHTML, like Gecko) Silk/3.47 like Chrome/37.0.2026.117 Safari/537.36",
 		UserAgent{
-			Browser{BrowserSilk, Version{3, 47, 0}}, OS{PlatformLinux, OSKindle, Version{4, 4, 3}}, DeviceTablet}},
+			Browser{Name: BrowserSilk, Version: Version{Major: 3, Minor: 47, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 4, Minor: 4, Patch: 3}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; U; en-us; KFJWI Build/IMM76D) AppleWebKit/535.19 (KHTML like Gecko) Silk/2.4 Safari/535.19 Silk-Acceleratedtrue",
 		UserAgent{
-			Browser{BrowserSilk, Version{2, 4, 0}}, OS{PlatformLinux, OSKindle, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSilk, Version: Version{Major: 2, Minor: 4, Patch: 0}, ServerRendered: true}, OS{PlatformLinux, OSKindle, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	// Opera
 	{"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.63 Safari/537.36 OPR/18.0.1284.68",
 		UserAgent{
-			Browser{BrowserOpera, Version{18, 0, 1284}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 18, Minor: 0, Patch: 1284}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 8_4 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) OPiOS/10.2.0.93022 Mobile/12H143 Safari/9537.53",
 		UserAgent{
-			Browser{BrowserOpera, Version{10, 2, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 4, 0}}, DevicePhone}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 10, Minor: 2, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 4, Patch: 0}}, DevicePhone}},
 
 	// Internet Explorer -- https://msdn.microsoft.com/en-us/library/hh869301(v=vs.85).aspx
 	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/42.0.2311.135 Safari/537.36 Edge/12.123",
 		UserAgent{
-			Browser{BrowserIE, Version{12, 123, 0}}, OS{PlatformWindows, OSWindows, Version{10, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 12, Minor: 123, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.2; Trident/6.0)",
 		UserAgent{
-			Browser{BrowserIE, Version{10, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows NT 6.3; Trident/7.0; .NET4.0E; .NET4.0C; rv:11.0) like Gecko",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 3, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 3, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 12_3_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.0 EdgiOS/44.3.5 Mobile/15E148 Safari/605.1.15",
 		UserAgent{
-			Browser{BrowserIE, Version{12, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{12, 3, 1}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 12, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 12, Minor: 3, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 12_3_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.0 EdgiOS/44.3.2 Mobile/15E148 Safari/605.1.15",
 		UserAgent{
-			Browser{BrowserIE, Version{12, 0, 0}}, OS{PlatformiPad, OSiOS, Version{12, 3, 1}}, DeviceTablet}},
+			Browser{Name: BrowserIE, Version: Version{Major: 12, Minor: 0, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 12, Minor: 3, Patch: 1}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; Android 9; motorola one) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/73.0.3683.90 Mobile Safari/537.36 EdgA/42.0.2.3728",
 		UserAgent{
-			Browser{BrowserIE, Version{42, 0, 2}}, OS{PlatformLinux, OSAndroid, Version{9, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 42, Minor: 0, Patch: 2}}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/76.0.3800.0 Safari/537.36 Edg/76.0.172.0",
 		UserAgent{
-			Browser{BrowserIE, Version{76, 0, 172}}, OS{PlatformWindows, OSWindows, Version{10, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 76, Minor: 0, Patch: 172}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/76.0.3803.0 Safari/537.36 Edg/76.0.176.0",
 		UserAgent{
-			Browser{BrowserIE, Version{76, 0, 176}}, OS{PlatformMac, OSMacOSX, Version{10, 14, 5}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 76, Minor: 0, Patch: 176}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 14, Patch: 5}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows Phone 10.0; Android 4.2.1; DEVICE INFO) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/42.0.2311.135 Mobile Safari/537.36 Edge/12.123",
 		UserAgent{
-			Browser{BrowserIE, Version{12, 123, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{10, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 12, Minor: 123, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Mobile; Windows Phone 8.1; Android 4.0; ARM; Trident/7.0; Touch; rv:11.0; IEMobile/11.0; NOKIA; Lumia 520) like iPhone OS 7_0_3 Mac OS X AppleWebKit/537 (KHTML, like Gecko) Mobile Safari/537",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{8, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 8, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/4.0 (compatible; MSIE 5.01; Windows NT 5.0; SV1; .NET CLR 1.1.4322; .NET CLR 1.0.3705; .NET CLR 2.0.50727)",
 		UserAgent{
-			Browser{BrowserIE, Version{5, 0, 1}}, OS{PlatformWindows, OSWindows, Version{5, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 5, Minor: 0, Patch: 1}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; WOW64; Trident/4.0; GTB6.4; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; OfficeLiveConnector.1.3; OfficeLivePatch.0.0; .NET CLR 1.1.4322)",
 		UserAgent{
-			Browser{BrowserIE, Version{7, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.2; ARM; Trident/6.0; Touch)", //Windows Surface RT tablet
 		UserAgent{
-			Browser{BrowserIE, Version{10, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceTablet}},
+			Browser{Name: BrowserIE, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceTablet}},
 
 	// UC Browser
 	{"Mozilla/5.0 (Linux; U; Android 2.3.4; en-US; MT11i Build/4.0.2.A.0.62) AppleWebKit/534.31 (KHTML, like Gecko) UCBrowser/9.0.1.275 U3/0.8.0 Mobile Safari/534.31",
 		UserAgent{
-			Browser{BrowserUCBrowser, Version{9, 0, 1}}, OS{PlatformLinux, OSAndroid, Version{2, 3, 4}}, DevicePhone}},
+			Browser{Name: BrowserUCBrowser, Version: Version{Major: 9, Minor: 0, Patch: 1}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 3, Patch: 4}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.0.4; en-US; Micromax P255 Build/IMM76D) AppleWebKit/534.31 (KHTML, like Gecko) UCBrowser/9.2.0.308 U3/0.8.0 Mobile Safari/534.31",
 		UserAgent{
-			Browser{BrowserUCBrowser, Version{9, 2, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 0, 4}}, DevicePhone}},
+			Browser{Name: BrowserUCBrowser, Version: Version{Major: 9, Minor: 2, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 0, Patch: 4}}, DevicePhone}},
 
 	{"UCWEB/2.0 (Java; U; MIDP-2.0; en-US; MicromaxQ5) U2/1.0.0 UCBrowser/9.4.0.342 U2/1.0.0 Mobile",
 		UserAgent{
-			Browser{BrowserUCBrowser, Version{9, 4, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserUCBrowser, Version: Version{Major: 9, Minor: 4, Patch: 0}, ServerRendered: true}, OS{PlatformUnknown, OSJ2ME, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone}},
 
 	// Nokia Browser
-	// {"Mozilla/5.0 (Series40; Nokia501/14.0.4/java_runtime_version=Nokia_Asha_1_2; Profile/MIDP-2.1 Configuration/CLDC-1.1) Gecko/20100401 S40OviBrowser/4.0.0.0.45",
-	// 	UserAgent{
-	//		Browser{BrowserUnknown, Version{4,0,0}}, OS{PlatformUnknown, OSUnknown, Version{0,0,0}}, DevicePhone}},
+	{"Mozilla/5.0 (Series40; Nokia501/14.0.4/java_runtime_version=Nokia_Asha_1_2; Profile/MIDP-2.1 Configuration/CLDC-1.1) Gecko/20100401 S40OviBrowser/4.0.0.0.45",
+		UserAgent{
+			Browser{Name: BrowserNokia, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSSeries40, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone}},
 
-	// {"Mozilla/5.0 (Symbian/3; Series60/5.3 NokiaN8-00/111.040.1511; Profile/MIDP-2.1 Configuration/CLDC-1.1 ) AppleWebKit/535.1 (KHTML, like Gecko) NokiaBrowser/8.3.1.4 Mobile Safari/535.1",
-	// 	UserAgent{
-	//		Browser{BrowserUnknown, Version{8,0,0}}, OS{PlatformUnknown, OSUnknown, Version{0,0,0}}, DevicePhone}},
+	{"Mozilla/5.0 (Symbian/3; Series60/5.3 NokiaN8-00/111.040.1511; Profile/MIDP-2.1 Configuration/CLDC-1.1 ) AppleWebKit/535.1 (KHTML, like Gecko) NokiaBrowser/8.3.1.4 Mobile Safari/535.1",
+		UserAgent{
+			Browser{Name: BrowserNokia, Version: Version{Major: 8, Minor: 3, Patch: 1}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+
+	{"NokiaN97/21.1.107 (SymbianOS/9.4; Series60/5.0 Mozilla/5.0; Profile/MIDP-2.1 Configuration/CLDC-1.1) AppleWebkit/525 (KHTML, like Gecko) BrowserNG/7.1.4",
+		UserAgent{
+			Browser{Name: BrowserNokia, Version: Version{Major: 7, Minor: 1, Patch: 4}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
 
-	// {"NokiaN97/21.1.107 (SymbianOS/9.4; Series60/5.0 Mozilla/5.0; Profile/MIDP-2.1 Configuration/CLDC-1.1) AppleWebkit/525 (KHTML, like Gecko) BrowserNG/7.1.4",
-	// 	BrowserUnknown, Version{7,0,0}}, OS{PlatformUnknown, OSUnknown, Version{0,0,0}}, DevicePhone}},
+	// NetFront
+	{"SonyEricssonK800i/R1KG Browser/NetFront/3.3 Profile/MIDP-2.0 Configuration/CLDC-1.1",
+		UserAgent{
+			Browser{Name: BrowserNetFront, Version: Version{Major: 3, Minor: 3, Patch: 0}}, OS{PlatformUnknown, OSJ2ME, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone}},
+
+	// Maxthon
+	{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/61.0.3163.79 Safari/537.36 Maxthon/5.2.1.6000",
+		UserAgent{
+			Browser{Name: BrowserMaxthon, Version: Version{Major: 5, Minor: 2, Patch: 1}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+
+	{"Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; Trident/4.0; Maxthon 2.0)",
+		UserAgent{
+			Browser{Name: BrowserMaxthon, Version: Version{Major: 2, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
+
+	{"Mozilla/5.0 (Linux; Android 10; SM-A505F Build/QP1A.190711.020; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/83.0.4103.106 Mobile Safari/537.36 MxBrowser/5.2.3.3800",
+		UserAgent{
+			Browser{Name: BrowserMaxthon, Version: Version{Major: 5, Minor: 2, Patch: 3}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
+
+	// Sogou Explorer
+	{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.81 Safari/537.36 SE 2.X MetaSr 1.0",
+		UserAgent{
+			Browser{Name: BrowserSogouExplorer, Version: Version{Major: 2, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+
+	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 5.1; Trident/4.0; SE 2.X MetaSr 1.0)",
+		UserAgent{
+			Browser{Name: BrowserSogouExplorer, Version: Version{Major: 2, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 1, Patch: 0}}, DeviceComputer}},
+
+	{"Mozilla/5.0 (Linux; Android 9; MI 8 Build/PKQ1.180729.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/66.0.3359.126 Mobile Safari/537.36 SogouMobileBrowser/5.22.8",
+		UserAgent{
+			Browser{Name: BrowserSogouExplorer, Version: Version{Major: 5, Minor: 22, Patch: 8}}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// ChromeOS
 	{"Mozilla/5.0 (X11; U; CrOS i686 9.10.0; en-US) AppleWebKit/532.5 (KHTML, like Gecko) Chrome/4.0.253.0 Safari/532.5",
 		UserAgent{
-			Browser{BrowserChrome, Version{4, 0, 253}}, OS{PlatformLinux, OSChromeOS, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 4, Minor: 0, Patch: 253}}, OS{PlatformLinux, OSChromeOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 	{"Mozilla/5.0 (X11; CrOS x86_64 15633.69.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.212 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{119, 0, 6045}}, OS{PlatformLinux, OSChromeOS, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 119, Minor: 0, Patch: 6045}}, OS{PlatformLinux, OSChromeOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	// iPod, iPod Touch
 	{"mozilla/5.0 (ipod touch; cpu iphone os 9_3_3 like mac os x) applewebkit/601.1.46 (khtml, like gecko) version/9.0 mobile/13g34 safari/601.1",
 		UserAgent{
-			Browser{BrowserSafari, Version{9, 0, 0}}, OS{PlatformiPod, OSiOS, Version{9, 3, 3}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 9, Minor: 0, Patch: 0}}, OS{PlatformiPod, OSiOS, Version{Major: 9, Minor: 3, Patch: 3}}, DeviceTablet}},
 
 	{"mozilla/5.0 (ipod; cpu iphone os 6_1_6 like mac os x) applewebkit/536.26 (khtml, like gecko) version/6.0 mobile/10b500 safari/8536.25",
 		UserAgent{
-			Browser{BrowserSafari, Version{6, 0, 0}}, OS{PlatformiPod, OSiOS, Version{6, 1, 6}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 6, Minor: 0, Patch: 0}}, OS{PlatformiPod, OSiOS, Version{Major: 6, Minor: 1, Patch: 6}}, DeviceTablet}},
 
 	// WebOS
 	{"Mozilla/5.0 (hp-tablet; Linux; hpwOS/3.0.0; U; de-DE) AppleWebKit/534.6 (KHTML, like Gecko) wOSBrowser/233.70 Safari/534.6 TouchPad/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (webOS/1.4.1.1; U; en-US) AppleWebKit/532.2 (KHTML, like Gecko) Version/1.0 Safari/532.2 Pre/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{1, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// Android WebView (Android <= 4.3)
 	{"Mozilla/5.0 (Linux; U; Android 2.2; en-us; DROID2 GLOBAL Build/S273) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 2, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 2, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.0.3; de-ch; HTC Sensation Build/IML74K) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari53/4.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 0, 3}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 0, Patch: 3}}, DevicePhone}},
 
 	// BlackBerry
 	{"Mozilla/5.0 (PlayBook; U; RIM Tablet OS 2.1.0; en-US) AppleWebKit/536.2+ (KHTML, like Gecko) Version/7.2.1.0 Safari/536.2+",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{7, 2, 1}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 7, Minor: 2, Patch: 1}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (BB10; Kbd) AppleWebKit/537.35+ (KHTML, like Gecko) Version/10.2.1.1925 Mobile Safari/537.35+",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{10, 2, 1}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 10, Minor: 2, Patch: 1}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/4.0 (compatible; MSIE 6.0; Windows NT 5.0) BlackBerry8703e/4.1.0 Profile/MIDP-2.0 Configuration/CLDC-1.1 VendorID/104",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{0, 0, 0}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// Windows Phone
 	{"Mozilla/5.0 (compatible; MSIE 10.0; Windows Phone 8.0; Trident/6.0; IEMobile/10.0; ARM; Touch; NOKIA; Lumia 625; ANZ941)",
 		UserAgent{
-			Browser{BrowserIE, Version{10, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{8, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 8, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (compatible; MSIE 9.0; Windows Phone OS 7.5; Trident/5.0; IEMobile/9.0; NOKIA; Lumia 900)",
 		UserAgent{
-			Browser{BrowserIE, Version{9, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{7, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 9, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 7, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	// Kindle eReader
 	{"Mozilla/5.0 (Linux; U; en-US) AppleWebKit/528.5+ (KHTML, like Gecko, Safari/528.5+) Version/4.0 Kindle/3.0 (screen 600×800; rotate)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{4, 0, 0}}, OS{PlatformLinux, OSKindle, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (X11; U; Linux armv7l like Android; en-us) AppleWebKit/531.2+ (KHTML, like Gecko) Version/5.0 Safari/533.2+ Kindle/3.0+",
 		UserAgent{
-			Browser{BrowserUnknown, Version{5, 0, 0}}, OS{PlatformLinux, OSKindle, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 5, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	// Amazon Fire
 	{"Mozilla/5.0 (Linux; U; Android 4.4.3; de-de; KFTHWI Build/KTU84M) AppleWebKit/537.36 (KHTML, like Gecko) Silk/3.67 like Chrome/39.0.2171.93 Safari/537.36",
 		UserAgent{
-			Browser{BrowserSilk, Version{3, 67, 0}}, OS{PlatformLinux, OSKindle, Version{4, 4, 3}}, DeviceTablet}}, // Fire tablet
+			Browser{Name: BrowserSilk, Version: Version{Major: 3, Minor: 67, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 4, Minor: 4, Patch: 3}}, DeviceTablet}}, // Fire tablet
 
 	{"Mozilla/5.0 (Linux; U; Android 4.2.2; enus; KFTHWI Build/JDQ39) AppleWebKit/537.36 (KHTML, like Gecko) Silk/3.22 like Chrome/34.0.1847.137 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserSilk, Version{3, 22, 0}}, OS{PlatformLinux, OSKindle, Version{4, 2, 2}}, DeviceTablet}}, // Fire tablet, but with "Mobile"
+			Browser{Name: BrowserSilk, Version: Version{Major: 3, Minor: 22, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 4, Minor: 2, Patch: 2}}, DeviceTablet}}, // Fire tablet, but with "Mobile"
 
 	{"Mozilla/5.0 (Linux; Android 4.4.4; SD4930UR Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/34.0.0.0 Mobile Safari/537.36 [FB_IAB/FB4A;FBAV/35.0.0.48.273;]",
 		UserAgent{
-			Browser{BrowserChrome, Version{34, 0, 0}}, OS{PlatformLinux, OSKindle, Version{4, 4, 4}}, DevicePhone}}, // Facebook app on Fire Phone
+			Browser{Name: BrowserChrome, Version: Version{Major: 34, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 4, Minor: 4, Patch: 4}}, DevicePhone}}, // Facebook app on Fire Phone
 
 	{"mozilla/5.0 (linux; android 4.4.3; kfthwi build/ktu84m) applewebkit/537.36 (khtml, like gecko) version/4.0 chrome/34.0.0.0 safari/537.36 [pinterest/android]",
 		UserAgent{
-			Browser{BrowserChrome, Version{34, 0, 0}}, OS{PlatformLinux, OSKindle, Version{4, 4, 3}}, DeviceTablet}}, // Fire tablet running pinterest
+			Browser{Name: BrowserChrome, Version: Version{Major: 34, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKindle, Version{Major: 4, Minor: 4, Patch: 3}}, DeviceTablet}}, // Fire tablet running pinterest
 
 	// extra logic to identify phone when using silk has not been added
 	// {"Mozilla/5.0 (Linux; Android 4.4.4; SD4930UR Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Silk/3.67 like Chrome/39.0.2171.93 Mobile Safari/537.36",
@@ -307,50 +339,50 @@ var testUAVars = []struct {
 	// Nintendo
 	{"Opera/9.30 (Nintendo Wii; U; ; 2047-7; fr)",
 		UserAgent{
-			Browser{BrowserOpera, Version{9, 30, 0}}, OS{PlatformNintendo, OSNintendo, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 9, Minor: 30, Patch: 0}}, OS{PlatformNintendo, OSNintendo, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	{"Mozilla/5.0 (Nintendo WiiU) AppleWebKit/534.52 (KHTML, like Gecko) NX/2.1.0.8.21 NintendoBrowser/1.0.0.7494.US",
 		UserAgent{
-			Browser{BrowserNintendo, Version{0, 0, 0}}, OS{PlatformNintendo, OSNintendo, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserNintendo, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformNintendo, OSNintendo, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	// Xbox
 	{"Mozilla/5.0 (compatible; MSIE 9.0; Windows NT 6.1; Trident/5.0; Xbox)", //Xbox 360
 		UserAgent{
-			Browser{BrowserIE, Version{9, 0, 0}}, OS{PlatformXbox, OSXbox, Version{6, 1, 0}}, DeviceConsole}},
+			Browser{Name: BrowserIE, Version: Version{Major: 9, Minor: 0, Patch: 0}}, OS{PlatformXbox, OSXbox, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceConsole}},
 
 	// Playstation
 	{"Mozilla/5.0 (PlayStation 4 4.50) AppleWebKit/601.2 (KHTML, like Gecko)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformPlaystation, OSPlaystation, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformPlaystation, OSPlaystation, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	{"Mozilla/5.0 (Playstation Vita 1.61) AppleWebKit/531.22.8 (KHTML, like Gecko) Silk/3.2",
 		UserAgent{
-			Browser{BrowserSilk, Version{3, 2, 0}}, OS{PlatformPlaystation, OSPlaystation, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserSilk, Version: Version{Major: 3, Minor: 2, Patch: 0}}, OS{PlatformPlaystation, OSPlaystation, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	// Smart TVs and TV dongles
 	{"Mozilla/5.0 (CrKey armv7l 1.4.15250) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/31.0.1650.0 Safari/537.36", // Chromecast
 		UserAgent{
-			Browser{BrowserChrome, Version{31, 0, 1650}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 31, Minor: 0, Patch: 1650}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	{"Mozilla/5.0 (Linux; GoogleTV 3.2; VAP430 Build/MASTER) AppleWebKit/534.24 (KHTML, like Gecko) Chrome/11.0.696.77 Safari/534.24", // Google TV
 		UserAgent{
-			Browser{BrowserChrome, Version{11, 0, 696}}, OS{PlatformLinux, OSAndroid, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 11, Minor: 0, Patch: 696}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 3, Minor: 2, Patch: 0}}, DeviceTV}},
 
 	{"Mozilla/5.0 (Linux; Android 5.0; ADT-1 Build/LPX13D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/40.0.2214.89 Mobile Safari/537.36", // Android TV
 		UserAgent{
-			Browser{BrowserChrome, Version{40, 0, 2214}}, OS{PlatformLinux, OSAndroid, Version{5, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 40, Minor: 0, Patch: 2214}}, OS{PlatformLinux, OSAndroid, Version{Major: 5, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	{"Mozilla/5.0 (Linux; Android 4.2.2; AFTB Build/JDQ39) AppleWebKit/537.22 (KHTML, like Gecko) Chrome/25.0.1364.173 Mobile Safari/537.22", // Amazon Fire
 		UserAgent{
-			Browser{BrowserChrome, Version{25, 0, 1364}}, OS{PlatformLinux, OSAndroid, Version{4, 2, 2}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 25, Minor: 0, Patch: 1364}}, OS{PlatformLinux, OSFireOS, Version{Major: 3, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	{"Mozilla/5.0 (Unknown; Linux armv7l) AppleWebKit/537.1+ (KHTML, like Gecko) Safari/537.1+ LG Browser/6.00.00(+mouse+3D+SCREEN+TUNER; LGE; GLOBAL-PLAT5; 03.07.01; 0x00000001;); LG NetCast.TV-2013/03.17.01 (LG, GLOBAL-PLAT4, wired)", // LG TV
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	{"Mozilla/5.0 (X11; FreeBSD; U; Viera; de-DE) AppleWebKit/537.11 (KHTML, like Gecko) Viera/3.10.0 Chrome/23.0.1271.97 Safari/537.11", // Panasonic Viera
 		UserAgent{
-			Browser{BrowserChrome, Version{23, 0, 1271}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 23, Minor: 0, Patch: 1271}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	// TODO: not catching "browser/" and reporting as safari -- ua string not being fully checked?
 	// {"Mozilla/5.0 (DTV) AppleWebKit/531.2+ (KHTML, like Gecko) Espial/6.1.5 AQUOSBrowser/2.0 (US01DTV;V;0001;0001)", // Sharp Aquos
@@ -358,172 +390,231 @@ var testUAVars = []struct {
 
 	{"Roku/DVP-5.2 (025.02E03197A)", // Roku
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserRoku, Version: Version{Major: 5, Minor: 2, Patch: 0}}, OS{PlatformLinux, OSRokuOS, Version{Major: 5, Minor: 2, Patch: 0}}, DeviceTV}},
 
 	{"mozilla/5.0 (smart-tv; linux; tizen 2.3) applewebkit/538.1 (khtml, like gecko) samsungbrowser/1.0 tv safari/538.1", // Samsung SmartTV
 		UserAgent{
-			Browser{BrowserSamsung, Version{0, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserSamsung, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSTizen, Version{Major: 2, Minor: 3, Patch: 0}}, DeviceTV}},
 
 	{"mozilla/5.0 (linux; u) applewebkit/537.36 (khtml, like gecko) version/4.0 mobile safari/537.36 smarttv/6.0 (netcast)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{4, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 
 	// Google search app (GSA) for iOS -- it's Safari in disguise as of v6
 	{"Mozilla/5.0 (iPad; CPU OS 8_3 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) GSA/6.0.51363 Mobile/12F69 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 3, 0}}, OS{PlatformiPad, OSiOS, Version{8, 3, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 3, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 8, Minor: 3, Patch: 0}}, DeviceTablet}},
 
 	// Spotify (applicable for advertising applications)
 	{"Mozilla/5.0 (Windows NT 5.1) AppleWebKit/537.36 (KHTML, like Gecko) Spotify/1.0.9.133 Safari/537.36",
 		UserAgent{
-			Browser{BrowserSpotify, Version{1, 0, 9}}, OS{PlatformWindows, OSWindows, Version{5, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSpotify, Version: Version{Major: 1, Minor: 0, Patch: 9}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_2) AppleWebKit/537.36 (KHTML, like Gecko) Spotify/1.0.9.133 Safari/537.36",
 		UserAgent{
-			Browser{BrowserSpotify, Version{1, 0, 9}}, OS{PlatformMac, OSMacOSX, Version{10, 10, 2}}, DeviceComputer}},
+			Browser{Name: BrowserSpotify, Version: Version{Major: 1, Minor: 0, Patch: 9}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 10, Patch: 2}}, DeviceComputer}},
+
+	{"Spotify/8.8.12 iOS/16.0 (iPhone14,2)",
+		UserAgent{
+			Browser{Name: BrowserSpotify, Version: Version{Major: 8, Minor: 8, Patch: 12}}, OS{PlatformiPhone, OSiOS, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+
+	// Media players and podcast apps
+	{"AppleCoreMedia/1.0.0.21A329 (iPhone; U; CPU OS 17_0 like Mac OS X; en_us)",
+		UserAgent{
+			Browser{Name: BrowserAppleCoreMedia, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 17, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"MyRadio/4.2.0 (Linux;Android 13) ExoPlayerLib/2.18.1",
+		UserAgent{
+			Browser{Name: BrowserExoPlayer, Version: Version{Major: 2, Minor: 18, Patch: 1}}, OS{PlatformLinux, OSAndroid, Version{Major: 13, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"stagefright/1.2 (Linux;Android 5.0)",
+		UserAgent{
+			Browser{Name: BrowserStagefright, Version: Version{Major: 1, Minor: 2, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 5, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"VLC/3.0.18 LibVLC/3.0.18",
+		UserAgent{
+			Browser{Name: BrowserVLC, Version: Version{Major: 3, Minor: 0, Patch: 18}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
+	{"Lavf/60.3.100",
+		UserAgent{
+			Browser{Name: BrowserFFmpeg, Version: Version{Major: 60, Minor: 3, Patch: 100}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
+	{"Lavf53.32.100",
+		UserAgent{
+			Browser{Name: BrowserFFmpeg, Version: Version{Major: 53, Minor: 32, Patch: 100}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
+	{"Roku/DVP-12.0 (12.0.0.4182-88)",
+		UserAgent{
+			Browser{Name: BrowserRoku, Version: Version{Major: 12, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSRokuOS, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
+	{"Overcast/3.0 (+http://overcast.fm/; iOS podcast app)",
+		UserAgent{
+			Browser{Name: BrowserOvercast, Version: Version{Major: 3, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
+	{"PocketCasts/1.0 (Pocket Casts Android, v7.20.2) Dalvik/2.1.0 (Linux; U; Android 13; Pixel 7 Build/TQ3A.230805.001)",
+		UserAgent{
+			Browser{Name: BrowserPocketCasts, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"iTunes/12.12.10 (Windows; Microsoft Windows 10 x64; x64) AppleWebKit/7613.2007.1014.14 (dt:2)",
+		UserAgent{
+			Browser{Name: BrowseriTunes, Version: Version{Major: 12, Minor: 12, Patch: 10}}, OS{PlatformWindows, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"iTunes/12.8 (Macintosh; OS X 10.13.6) AppleWebKit/605.1.15",
+		UserAgent{
+			Browser{Name: BrowseriTunes, Version: Version{Major: 12, Minor: 8, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 13, Patch: 6}}, DeviceComputer}},
+
+	// Email clients and proxies
+	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 10.0; WOW64; Trident/7.0; .NET4.0C; .NET4.0E; Microsoft Outlook 16.0.5095; ms-office; MSOffice 16)",
+		UserAgent{
+			Browser{Name: BrowserOutlook, Version: Version{Major: 16, Minor: 0, Patch: 5095}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Microsoft Office/16.0 (Microsoft Outlook Mail 16.0.13328; Pro)",
+		UserAgent{
+			Browser{Name: BrowserOutlook, Version: Version{Major: 16, Minor: 0, Patch: 13328}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 15, Patch: 7}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Thunderbird/115.3.1",
+		UserAgent{
+			Browser{Name: BrowserThunderbird, Version: Version{Major: 115, Minor: 3, Patch: 1}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Mail/3731.600.7",
+		UserAgent{
+			Browser{Name: BrowserAppleMail, Version: Version{Major: 3731, Minor: 600, Patch: 7}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 14, Patch: 6}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 5.1; rv:11.0) Gecko Firefox/11.0 (via ggpht.com GoogleImageProxy)",
+		UserAgent{
+			Browser{Name: BrowserGoogleImageProxy, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
+	{"YahooMailProxy; https://help.yahoo.com/kb/yahoo-mail-proxy-SLN28749.html",
+		UserAgent{
+			Browser{Name: BrowserYahooMailProxy, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceUnknown}},
 
 	// OCSP fetchers
 	{"Microsoft-CryptoAPI/10.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformWindows, OSUnknown, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 	{"trustd (unknown version) CFNetwork/811.7.2 Darwin/16.7.0 (x86_64)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformMac, OSUnknown, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformMac, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 	{"ocspd (unknown version) CFNetwork/520.5.3 Darwin/11.4.2 (x86_64)(MacBookAir5%2C2)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformMac, OSUnknown, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformMac, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 	// Bots
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_1) AppleWebKit/600.2.5 (KHTML, like Gecko) Version/8.0.2 Safari/600.2.5 (Applebot/0.1; +http://www.apple.com/go/applebot)",
 		UserAgent{
-			Browser{BrowserAppleBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{10, 10, 1}}, DeviceComputer}},
+			Browser{Name: BrowserAppleBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 10, Minor: 10, Patch: 1}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; Baiduspider/2.0; +http://www.baidu.com/search/spider.html)",
 		UserAgent{
-			Browser{BrowserBaiduBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserBaiduBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)",
 		UserAgent{
-			Browser{BrowserBingBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserBingBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"DuckDuckBot/1.0; (+http://duckduckgo.com/duckduckbot.html)",
 		UserAgent{
-			Browser{BrowserDuckDuckGoBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserDuckDuckGoBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
 		UserAgent{
-			Browser{BrowserFacebookBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFacebookBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Facebot/1.0",
 		UserAgent{
-			Browser{BrowserFacebookBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFacebookBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
 		UserAgent{
-			Browser{BrowserGoogleBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserGoogleBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"LinkedInBot/1.0 (compatible; Mozilla/5.0; Jakarta Commons-HttpClient/3.1 +http://www.linkedin.com)",
 		UserAgent{
-			Browser{BrowserLinkedInBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserLinkedInBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"msnbot/2.0b (+http://search.msn.com/msnbot.htm)",
 		UserAgent{
-			Browser{BrowserMsnBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserMsnBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Pingdom.com_bot_version_1.4_(http://www.pingdom.com/)",
 		UserAgent{
-			Browser{BrowserPingdomBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserPingdomBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Twitterbot/1.0",
 		UserAgent{
-			Browser{BrowserTwitterBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserTwitterBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; YandexBot/3.0; +http://yandex.com/bots)",
 		UserAgent{
-			Browser{BrowserYandexBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserYandexBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; Yahoo! Slurp; http://help.yahoo.com/help/us/ysearch/slurp)",
 		UserAgent{
-			Browser{BrowserYahooBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserYahooBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"{UA:Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2272.96 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)}, ua: &{Browser:{Name:BrowserGoogleBot Version:{Major:0 Minor:0 Patch:0}} OS:{Platform:PlatformBot Name:OSBot Version:{Major:6 Minor:0 Patch:1}} DeviceType:DeviceComputer}",
 		UserAgent{
-			Browser{BrowserGoogleBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{6, 0, 1}}, DeviceComputer}},
+			Browser{Name: BrowserGoogleBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 6, Minor: 0, Patch: 1}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 6_0 like Mac OS X) AppleWebKit/536.26 (KHTML, like Gecko) Version/6.0 Mobile/10A5376e Safari/8536.25 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
 		UserAgent{
-			Browser{BrowserGoogleBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{6, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserGoogleBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 6, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"mozilla/5.0 (unknown; linux x86_64) applewebkit/538.1 (khtml, like gecko) phantomjs/2.1.1 safari/538.1",
 		UserAgent{
-			Browser{BrowserBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	// Unknown or partially handled
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10.4; en-US; rv:1.9.1b3pre) Gecko/20090223 SeaMonkey/2.0a3", //Seamonkey (~FF)
 		UserAgent{
-			Browser{BrowserFirefox, Version{0, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 4, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSeaMonkey, Version: Version{Major: 2, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 4, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10.5; en; rv:1.9.0.8pre) Gecko/2009022800 Camino/2.0b3pre", //Camino (~FF)
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 5, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 5, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Mobile; rv:26.0) Gecko/26.0 Firefox/26.0", //firefox OS
 		UserAgent{
-			Browser{BrowserFirefox, Version{26, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 26, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/535.19 (KHTML, like Gecko) Chrome/18.0.1025.45 Safari/535.19", //chrome for android having requested desktop site
 		UserAgent{
-			Browser{BrowserChrome, Version{18, 0, 1025}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 18, Minor: 0, Patch: 1025}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Opera/9.80 (S60; SymbOS; Opera Mobi/352; U; de) Presto/2.4.15 Version/10.00",
 		UserAgent{
-			Browser{BrowserOpera, Version{10, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// BrowserQQ
 	{"Mozilla/5.0 (Windows NT 6.2; WOW64; Trident/7.0; Touch; .NET4.0E; .NET4.0C; .NET CLR 3.5.30729; .NET CLR 2.0.50727; .NET CLR 3.0.30729; InfoPath.3; Tablet PC 2.0; QQBrowser/7.6.21433.400; rv:11.0) like Gecko",
 		UserAgent{
-			Browser{BrowserQQ, Version{7, 6, 21433}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceTablet}},
+			Browser{Name: BrowserQQ, Version: Version{Major: 7, Minor: 6, Patch: 21433}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.124 Safari/537.36 QQBrowser/9.0.2191.400",
 		UserAgent{
-			Browser{BrowserQQ, Version{9, 0, 2191}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserQQ, Version: Version{Major: 9, Minor: 0, Patch: 2191}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"mozilla/5.0 (iphone; cpu iphone os 8_1_2 like mac os x) applewebkit/600.1.4 (khtml, like gecko) mobile/12b440 qq/5.3.0.319 nettype/wifi mem/205",
 		UserAgent{
-			Browser{BrowserQQ, Version{5, 3, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 1, 2}}, DevicePhone}},
+			Browser{Name: BrowserQQ, Version: Version{Major: 5, Minor: 3, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 1, Patch: 2}}, DevicePhone}},
 
 	// ANDROID TESTS
 
 	{"Mozilla/5.0 (Linux; U; Android 1.0; en-us; dream) AppleWebKit/525.10+ (KHTML,like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.0; en-us; generic) AppleWebKit/525.10 (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.0.3; de-de; A80KSC Build/ECLAIR) AppleWebKit/530.17 (KHTML, like Gecko) Version/4.0 Mobile Safari/530.17",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{1, 0, 3}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 0, Patch: 3}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.5; en-gb; T-Mobile G1 Build/CRC1) AppleWebKit/528.5+ (KHTML, like Gecko) Version/3.1.2 Mobile Safari/525.20.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 1, 2}}, OS{PlatformLinux, OSAndroid, Version{1, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 1, Patch: 2}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.5; es-; FBW1_4 Build/MASTER) AppleWebKit/525.10+ (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux U; Android 1.5 en-us hero) AppleWebKit/525.10+ (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.5; en-us; Opus One Build/RBE.00.00) AppleWebKit/528.18.1 (KHTML, like Gecko) Version/3.1.1 Mobile Safari/525.20.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 1, 1}}, OS{PlatformLinux, OSAndroid, Version{1, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 1, Patch: 1}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.6; ar-us; SonyEricssonX10i Build/R2BA026) AppleWebKit/528.5+ (KHTML, like Gecko) Version/3.1.2 Mobile Safari/525.20.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 1, 2}}, OS{PlatformLinux, OSAndroid, Version{1, 6, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 1, Patch: 2}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 6, Patch: 0}}, DevicePhone}},
 
 	// TODO: support names of Android OS?
 	//{"Mozilla/5.0 (Linux; U; Android Donut; de-de; HTC Tattoo 1.52.161.1 Build/Donut) AppleWebKit/528.5+ (KHTML, like Gecko) Version/3.1.2 Mobile Safari/525.20.1",
@@ -532,61 +623,61 @@ var testUAVars = []struct {
 
 	{"Mozilla/5.0 (Linux; U; Android 1.6; en-gb; HTC Tattoo Build/DRC79) AppleWebKit/525.10+ (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 6, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 6, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 1.6; ja-jp; Docomo HT-03A Build/DRD08) AppleWebKit/525.10 (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{1, 6, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 1, Minor: 6, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.1; en-us; Nexus One Build/ERD62) AppleWebKit/530.17 (KHTML, like Gecko) Version/4.0 Mobile Safari/530.17",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.1-update1; en-au; HTC_Desire_A8183 V1.16.841.1 Build/ERE27) AppleWebKit/530.17 (KHTML, like Gecko) Version/4.0 Mobile Safari/530.17",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.1; en-us; generic) AppleWebKit/525.10+ (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{2, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	// TODO support named versions of Android?
 	{"Mozilla/5.0 (Linux; U; Android Eclair; en-us; sholes) AppleWebKit/525.10+ (KHTML, like Gecko) Version/3.0.4 Mobile Safari/523.12.2",
 		UserAgent{
-			Browser{BrowserAndroid, Version{3, 0, 4}}, OS{PlatformLinux, OSAndroid, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 3, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSAndroid, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.2; en-sa; HTC_DesireHD_A9191 Build/FRF91) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 2, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 2, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.2.1; en-gb; HTC_DesireZ_A7272 Build/FRG83D) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 2, 1}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 2, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.3.3; en-us; Sensation_4G Build/GRI40) AppleWebKit/533.1 (KHTML, like Gecko) Version/5.0 Safari/533.16",
 		UserAgent{
-			Browser{BrowserAndroid, Version{5, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 3, 3}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 5, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 3, Patch: 3}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.3.5; ko-kr; SHW-M250S Build/GINGERBREAD) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 3, 5}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 3, Patch: 5}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 2.3.7; ja-jp; L-02D Build/GWK74) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{2, 3, 7}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 2, Minor: 3, Patch: 7}}, DevicePhone}},
 
 	// TODO: is tablet, not phone
 	{"Mozilla/5.0 (Linux; U; Android 3.0; xx-xx; Transformer TF101 Build/HRI66) AppleWebKit/534.13 (KHTML, like Gecko) Version/4.0 Safari/534.13",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{3, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 3, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 3.0; en-us; Xoom Build/HRI39) AppleWebKit/534.13 (KHTML, like Gecko) Version/4.0 Safari/534.13",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{3, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 3, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.0.1; en-us; sdk Build/ICS_MR0) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 0, 1}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 0, Patch: 1}}, DevicePhone}},
 
 	// TODO support "android-" version prefix
 	// However, can't find reference to this naming scheme in real-world UA gathering
@@ -596,81 +687,81 @@ var testUAVars = []struct {
 
 	{"Mozilla/5.0 (Linux; U; Android 4.1.1; en-us; Nexus S Build/JRO03E) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 1, 1}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 1, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.1; en-gb; Build/JRN84D) AppleWebKit/534.30 (KHTML like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.1.1; el-gr; MB525 Build/JRO03H; CyanogenMod-10) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 1, 1}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 1, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.1.1; fr-fr; MB525 Build/JRO03H; CyanogenMod-10) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 1, 1}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 1, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.2; en-us; Nexus 10 Build/JVP15I) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 2, 0}}, DeviceTablet}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 2, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; U; Android 4.2; ro-ro; LT18i Build/4.1.B.0.431) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
 		UserAgent{
-			Browser{BrowserAndroid, Version{4, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{4, 2, 0}}, DevicePhone}},
+			Browser{Name: BrowserAndroid, Version: Version{Major: 4, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 2, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; Android 4.3; Nexus 7 Build/JWR66D) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/27.0.1453.111 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{27, 0, 1453}}, OS{PlatformLinux, OSAndroid, Version{4, 3, 0}}, DeviceTablet}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 27, Minor: 0, Patch: 1453}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 3, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; Android 4.4; Nexus 7 Build/KOT24) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/30.0.1599.105 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{30, 0, 1599}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 0}}, DeviceTablet}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 30, Minor: 0, Patch: 1599}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (Linux; Android 4.4; Nexus 4 Build/KRT16E) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/30.0.1599.105 Mobile Safari",
 		UserAgent{
-			Browser{BrowserChrome, Version{30, 0, 1599}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 0}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 30, Minor: 0, Patch: 1599}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; Android 6.0.1; SM-G930V Build/MMB29M) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.98 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{52, 0, 2743}}, OS{PlatformLinux, OSAndroid, Version{6, 0, 1}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 52, Minor: 0, Patch: 2743}}, OS{PlatformLinux, OSAndroid, Version{Major: 6, Minor: 0, Patch: 1}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; Android 7.0; Nexus 5X Build/NRD90M) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.98 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{52, 0, 2743}}, OS{PlatformLinux, OSAndroid, Version{7, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 52, Minor: 0, Patch: 2743}}, OS{PlatformLinux, OSAndroid, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Linux; Android 7.0; Nexus 6P Build/NRD90M; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/52.0.2743.98 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{52, 0, 2743}}, OS{PlatformLinux, OSAndroid, Version{7, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 52, Minor: 0, Patch: 2743}}, OS{PlatformLinux, OSAndroid, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// BLACKBERRY TESTS
 
 	{"Mozilla/4.0 (compatible; MSIE 6.0; Windows NT 5.0) BlackBerry8703e/4.1.0 Profile/MIDP-2.0 Configuration/CLDC-1.1 VendorID/104",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{0, 0, 0}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (BB10; Touch) AppleWebKit/537.10+ (KHTML, like Gecko) Version/10.1.0.4633 Mobile Safari/537.10+",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{10, 1, 0}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 10, Minor: 1, Patch: 0}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (BB10; Kbd) AppleWebKit/537.35+ (KHTML, like Gecko) Version/10.2.1.1925 Mobile Safari/537.35+",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{10, 2, 1}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 10, Minor: 2, Patch: 1}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (PlayBook; U; RIM Tablet OS 1.0.0; en-US) AppleWebKit/534.11 (KHTML, like Gecko) Version/7.1.0.7 Safari/534.11",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{7, 1, 0}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 7, Minor: 1, Patch: 0}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (PlayBook; U; RIM Tablet OS 2.1.0; en-US) AppleWebKit/536.2+ (KHTML, like Gecko) Version/7.2.1.0 Safari/536.2+",
 		UserAgent{
-			Browser{BrowserBlackberry, Version{7, 2, 1}}, OS{PlatformBlackberry, OSBlackberry, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserBlackberry, Version: Version{Major: 7, Minor: 2, Patch: 1}}, OS{PlatformBlackberry, OSBlackberry, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (X11; U; CrOS i686 9.10.0; en-US) AppleWebKit/532.5 (KHTML, like Gecko) Chrome/4.0.253.0 Safari/532.5",
 		UserAgent{
-			Browser{BrowserChrome, Version{4, 0, 253}}, OS{PlatformLinux, OSChromeOS, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 4, Minor: 0, Patch: 253}}, OS{PlatformLinux, OSChromeOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (X11; CrOS armv7l 5500.100.6) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/34.0.1847.120 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{34, 0, 1847}}, OS{PlatformLinux, OSChromeOS, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 34, Minor: 0, Patch: 1847}}, OS{PlatformLinux, OSChromeOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	// {"Mozilla/5.0 (Mobile; rv:14.0) Gecko/14.0 Firefox/14.0",
 	// 	UserAgent{
@@ -694,39 +785,39 @@ var testUAVars = []struct {
 
 	{"Mozilla/5.0(iPad; U; CPU iPhone OS 3_2 like Mac OS X; en-us) AppleWebKit/531.21.10 (KHTML, like Gecko) Version/4.0.4 Mobile/7B314 Safari/531.21.10",
 		UserAgent{
-			Browser{BrowserSafari, Version{4, 0, 4}}, OS{PlatformiPad, OSiOS, Version{3, 2, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 4, Minor: 0, Patch: 4}}, OS{PlatformiPad, OSiOS, Version{Major: 3, Minor: 2, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPhone; U; CPU iPhone OS 4_0 like Mac OS X; en-us) AppleWebKit/532.9 (KHTML, like Gecko) Version/4.0.5 Mobile/8A293 Safari/6531.22.7",
 		UserAgent{
-			Browser{BrowserSafari, Version{4, 0, 5}}, OS{PlatformiPhone, OSiOS, Version{4, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 4, Minor: 0, Patch: 5}}, OS{PlatformiPhone, OSiOS, Version{Major: 4, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 5_0 like Mac OS X) AppleWebKit/534.46 (KHTML, like Gecko) Version/5.1 Mobile/9A334 Safari/7534.48.3",
 		UserAgent{
-			Browser{BrowserSafari, Version{5, 1, 0}}, OS{PlatformiPhone, OSiOS, Version{5, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 5, Minor: 1, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 5, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 5_0 like Mac OS X) AppleWebKit/534.46 (KHTML, like Gecko) Version/5.1 Mobile/9A334 Safari/7534.48.3",
 		UserAgent{
-			Browser{BrowserSafari, Version{5, 1, 0}}, OS{PlatformiPad, OSiOS, Version{5, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 5, Minor: 1, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 5, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 6_0 like Mac OS X) AppleWebKit/536.26 (KHTML, like Gecko) Version/6.0 Mobile/10A5355d Safari/8536.25",
 		UserAgent{
-			Browser{BrowserSafari, Version{6, 0, 0}}, OS{PlatformiPad, OSiOS, Version{6, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 6, Minor: 0, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 6, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 7_0 like Mac OS X) AppleWebKit/546.10 (KHTML, like Gecko) Version/6.0 Mobile/7E18WD Safari/8536.25",
 		UserAgent{
-			Browser{BrowserSafari, Version{6, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{7, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 6, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 7_0 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) Version/7.0 Mobile/11A465 Safari/9537.53",
 		UserAgent{
-			Browser{BrowserSafari, Version{7, 0, 0}}, OS{PlatformiPad, OSiOS, Version{7, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 7, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPad; CPU OS 7_0_2 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) Version/7.0 Mobile/11A501 Safari/9537.53",
 		UserAgent{
-			Browser{BrowserSafari, Version{7, 0, 0}}, OS{PlatformiPad, OSiOS, Version{7, 0, 2}}, DeviceTablet}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformiPad, OSiOS, Version{Major: 7, Minor: 0, Patch: 2}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_2_1 like Mac OS X) AppleWebKit/602.4.6 (KHTML, like Gecko) Mobile/14D27 [FBAN/FBIOS;FBAV/86.0.0.48.52;FBBV/53842252;FBDV/iPhone9,1;FBMD/iPhone;FBSN/iOS;FBSV/10.2.1;FBSS/2;FBCR/Verizon;FBID/phone;FBLC/en_US;FBOP/5;FBRV/0]",
 		UserAgent{
-			Browser{BrowserSafari, Version{10, 2, 1}}, OS{PlatformiPhone, OSiOS, Version{10, 2, 1}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 10, Minor: 2, Patch: 1}}, OS{PlatformiPhone, OSiOS, Version{Major: 10, Minor: 2, Patch: 1}}, DevicePhone}},
 
 	// TODO handle default browser based on iOS version
 	// {"Mozilla/5.0 (iPhone; CPU iPhone OS 8_0 like Mac OS X) AppleWebKit/538.34.9 (KHTML, like Gecko) Mobile/12A4265u",
@@ -740,24 +831,24 @@ var testUAVars = []struct {
 
 	{"Mozilla/5.0 (iPhone; CPU iPhone OS 8_0_2 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) Version/8.0 Mobile/12A405 Safari/600.1.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 0}}, OS{PlatformiPhone, OSiOS, Version{8, 0, 2}}, DevicePhone}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 8, Minor: 0, Patch: 2}}, DevicePhone}},
 
 	{"Mozilla/5.0 (X11; U; Linux x86_64; en; rv:1.9.0.14) Gecko/20080528 Ubuntu/9.10 (karmic) Epiphany/2.22 Firefox/3.0",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 3, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	// Can't parse browser due to limitation of user agent library
 	{"Mozilla/5.0 (X11; U; Linux x86_64; zh-TW; rv:1.9.0.8) Gecko/2009032712 Ubuntu/8.04 (hardy) Firefox/3.0.8 GTB5",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 0, 8}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 3, Minor: 0, Patch: 8}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; Konqueror/3.5; Linux; x86_64) KHTML/3.5.5 (like Gecko) (Debian)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (X11; U; Linux i686; de; rv:1.9.1.5) Gecko/20091112 Iceweasel/3.5.5 (like Firefox/3.5.5; Debian-3.5.5-1)",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 5, 5}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIceweasel, Version: Version{Major: 3, Minor: 5, Patch: 5}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	// TODO consider bot?
 	// {"Miro/2.0.4 (http://www.getmiro.com/; Darwin 10.3.0 i386)",
@@ -766,63 +857,63 @@ var testUAVars = []struct {
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10.4; en-US; rv:1.9.1b3pre) Gecko/20090223 SeaMonkey/2.0a3",
 		UserAgent{
-			Browser{BrowserFirefox, Version{0, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 4, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSeaMonkey, Version: Version{Major: 2, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 4, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10_5_5; en-us) AppleWebKit/525.26.2 (KHTML, like Gecko) Version/3.2 Safari/525.26.12",
 		UserAgent{
-			Browser{BrowserSafari, Version{3, 2, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 5, 5}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 3, Minor: 2, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 5, Patch: 5}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10.5; en; rv:1.9.0.8pre) Gecko/2009022800 Camino/2.0b3pre",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 5, 0}}, DeviceComputer}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 5, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10_6_2; en-US) AppleWebKit/533.1 (KHTML, like Gecko) Chrome/5.0.329.0 Safari/533.1",
 		UserAgent{
-			Browser{BrowserChrome, Version{5, 0, 329}}, OS{PlatformMac, OSMacOSX, Version{10, 6, 2}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 5, Minor: 0, Patch: 329}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 6, Patch: 2}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10.6; en-US; rv:1.9.1.6) Gecko/20091201 Firefox/3.5.6 (.NET CLR 3.5.30729)",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 5, 6}}, OS{PlatformMac, OSMacOSX, Version{10, 6, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 3, Minor: 5, Patch: 6}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 6, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_2) AppleWebKit/534.52.7 (KHTML, like Gecko) Version/5.1.2 Safari/534.52.7",
 		UserAgent{
-			Browser{BrowserSafari, Version{5, 1, 2}}, OS{PlatformMac, OSMacOSX, Version{10, 7, 2}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 5, Minor: 1, Patch: 2}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 7, Patch: 2}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.7; rv:9.0) Gecko/20111222 Thunderbird/9.0.1",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 7, 0}}, DeviceComputer}},
+			Browser{Name: BrowserThunderbird, Version: Version{Major: 9, Minor: 0, Patch: 1}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 7, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_7_2) AppleWebKit/535.7 (KHTML, like Gecko) Chrome/16.0.912.75 Safari/535.7",
 		UserAgent{
-			Browser{BrowserChrome, Version{16, 0, 912}}, OS{PlatformMac, OSMacOSX, Version{10, 7, 2}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 16, Minor: 0, Patch: 912}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 7, Patch: 2}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_8) AppleWebKit/535.18.5 (KHTML, like Gecko) Version/5.2 Safari/535.18.5",
 		UserAgent{
-			Browser{BrowserSafari, Version{5, 2, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 8, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 5, Minor: 2, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 8, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X 10_8; en-US) AppleWebKit/532.5 (KHTML, like Gecko) Chrome/4.0.249.0 Safari/532.5",
 		UserAgent{
-			Browser{BrowserChrome, Version{4, 0, 249}}, OS{PlatformMac, OSMacOSX, Version{10, 8, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 4, Minor: 0, Patch: 249}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 8, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_9) AppleWebKit/537.35.1 (KHTML, like Gecko) Version/6.1 Safari/537.35.1",
 		UserAgent{
-			Browser{BrowserSafari, Version{6, 1, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 9, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 6, Minor: 1, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 9, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10) AppleWebKit/538.34.48 (KHTML, like Gecko) Version/8.0 Safari/538.35.8",
 		UserAgent{
-			Browser{BrowserSafari, Version{8, 0, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 10, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 10, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10) AppleWebKit/538.32 (KHTML, like Gecko) Version/7.1 Safari/538.4",
 		UserAgent{
-			Browser{BrowserSafari, Version{7, 1, 0}}, OS{PlatformMac, OSMacOSX, Version{10, 10, 0}}, DeviceComputer}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 7, Minor: 1, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 10, Patch: 0}}, DeviceComputer}},
 
 	{"Opera/9.80 (S60; SymbOS; Opera Mobi/352; U; de) Presto/2.4.15 Version/10.00",
 		UserAgent{
-			Browser{BrowserOpera, Version{10, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Opera/9.80 (S60; SymbOS; Opera Mobi/352; U; de) Presto/2.4.15 Version/10.00",
 		UserAgent{
-			Browser{BrowserOpera, Version{10, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	// TODO: support OneBrowser? https://play.google.com/store/apps/details?id=com.tencent.ibibo.mtt&hl=en_GB
 	// {"OneBrowser/3.1 (NokiaN70-1/5.0638.3.0.1)",
@@ -832,31 +923,31 @@ var testUAVars = []struct {
 	// WebOS reports itself as safari :(
 	{"Mozilla/5.0 (webOS/1.0; U; en-US) AppleWebKit/525.27.1 (KHTML, like Gecko) Version/1.0 Safari/525.27.1 Pre/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{1, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (webOS/1.4.1.1; U; en-US) AppleWebKit/532.2 (KHTML, like Gecko) Version/1.0 Safari/532.2 Pre/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{1, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (hp-tablet; Linux; hpwOS/3.0.0; U; de-DE) AppleWebKit/534.6 (KHTML, like Gecko) wOSBrowser/233.70 Safari/534.6 TouchPad/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (hp-tablet; Linux; hpwOS/3.0.2; U; en-US) AppleWebKit/534.6 (KHTML, like Gecko) wOSBrowser/234.40.1 Safari/534.6 TouchPad/1.0",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSWebOS, Version{0, 0, 0}}, DeviceTablet}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSWebOS, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTablet}},
 
 	{"Opera/9.30 (Nintendo Wii; U; ; 2047-7; fr)",
 		UserAgent{
-			Browser{BrowserOpera, Version{9, 30, 0}}, OS{PlatformNintendo, OSNintendo, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 9, Minor: 30, Patch: 0}}, OS{PlatformNintendo, OSNintendo, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	{"Mozilla/5.0 (Nintendo WiiU) AppleWebKit/534.52 (KHTML, like Gecko) NX/2.1.0.8.21 NintendoBrowser/1.0.0.7494.US",
 		UserAgent{
-			Browser{BrowserNintendo, Version{0, 0, 0}}, OS{PlatformNintendo, OSNintendo, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserNintendo, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformNintendo, OSNintendo, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	{"Mozilla/5.0 (Nintendo WiiU) AppleWebKit/536.28 (KHTML, like Gecko) NX/3.0.3.12.6 NintendoBrowser/2.0.0.9362.US",
 		UserAgent{
-			Browser{BrowserNintendo, Version{0, 0, 0}}, OS{PlatformNintendo, OSNintendo, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserNintendo, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformNintendo, OSNintendo, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 
 	// TODO fails to get opera first -- but is this a real UA string or an uncommon spoof?
 	// {"Mozilla/4.0 (compatible; MSIE 5.0; Windows 2000) Opera 6.0 [en]",
@@ -864,35 +955,35 @@ var testUAVars = []struct {
 
 	{"Mozilla/4.0 (compatible; MSIE 5.01; Windows NT 5.0; SV1; .NET CLR 1.1.4322; .NET CLR 1.0.3705; .NET CLR 2.0.50727)",
 		UserAgent{
-			Browser{BrowserIE, Version{5, 0, 1}}, OS{PlatformWindows, OSWindows, Version{5, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 5, Minor: 0, Patch: 1}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; WOW64; Trident/4.0; GTB6.4; SLCC2; .NET CLR 2.0.50727; .NET CLR 3.5.30729; .NET CLR 3.0.30729; Media Center PC 6.0; OfficeLiveConnector.1.3; OfficeLivePatch.0.0; .NET CLR 1.1.4322)",
 		UserAgent{
-			Browser{BrowserIE, Version{7, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows; U; Windows NT 6.1; sk; rv:1.9.1.7) Gecko/20091221 Firefox/3.5.7",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 5, 7}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 3, Minor: 5, Patch: 7}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.2; Trident/6.0)",
 		UserAgent{
-			Browser{BrowserIE, Version{10, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows NT 6.2; WOW64) AppleWebKit/536.5 (KHTML, like Gecko) YaBrowser/1.0.1084.5402 Chrome/19.0.1084.5402 Safari/536.5",
 		UserAgent{
-			Browser{BrowserYandex, Version{1, 0, 1084}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceComputer}},
+			Browser{Name: BrowserYandex, Version: Version{Major: 1, Minor: 0, Patch: 1084}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows NT 6.2; WOW64) AppleWebKit/537.15 (KHTML, like Gecko) Chrome/24.0.1295.0 Safari/537.15",
 		UserAgent{
-			Browser{BrowserChrome, Version{24, 0, 1295}}, OS{PlatformWindows, OSWindows, Version{6, 2, 0}}, DeviceComputer}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 24, Minor: 0, Patch: 1295}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 2, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows NT 6.3; WOW64; Trident/7.0; Touch; rv:11.0) like Gecko",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 3, 0}}, DeviceTablet}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 3, Patch: 0}}, DeviceTablet}},
 
 	{"Mozilla/5.0 (IE 11.0; Windows NT 6.3; Trident/7.0; .NET4.0E; .NET4.0C; rv:11.0) like Gecko",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 3, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 3, Patch: 0}}, DeviceComputer}},
 
 	// {"Mozilla/4.0 (compatible; MSIE 4.01; Windows 95)",
 	// 	UserAgent{
@@ -930,188 +1021,364 @@ var testUAVars = []struct {
 
 	{"Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.0; Trident/4.0; SLCC1; .NET CLR 2.0.50727; .NET CLR 1.1.4322; InfoPath.2; .NET CLR 3.5.21022; .NET CLR 3.5.30729; MS-RTC LM 8; OfficeLiveConnector.1.4; OfficeLivePatch.1.3; .NET CLR 3.0.30729)",
 		UserAgent{
-			Browser{BrowserIE, Version{8, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 8, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Windows; U; Windows NT 5.1; cs; rv:1.9.1.8) Gecko/20100202 Firefox/3.5.8",
 		UserAgent{
-			Browser{BrowserFirefox, Version{3, 5, 8}}, OS{PlatformWindows, OSWindows, Version{5, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserFirefox, Version: Version{Major: 3, Minor: 5, Patch: 8}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 5.1; )",
 		UserAgent{
-			Browser{BrowserIE, Version{7, 0, 0}}, OS{PlatformWindows, OSWindows, Version{5, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 5, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	// Can't parse due to limitation of user agent library
 	{"Mozilla/4.0 (compatible; MSIE 6.0; Windows NT 5.1; Windows Phone 6.5.3.5)",
 		UserAgent{
-			Browser{BrowserIE, Version{6, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{6, 5, 3}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 6, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 6, Minor: 5, Patch: 3}}, DevicePhone}},
 
 	// desktop mode for Windows Phone 7
 	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; XBLWP7; ZuneWP7)",
 		UserAgent{
-			Browser{BrowserIE, Version{7, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 1, 0}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
 
 	// mobile mode for Windows Phone 7
 	{"Mozilla/4.0 (compatible; MSIE 7.0; Windows Phone OS 7.0; Trident/3.1; IEMobile/7.0; HTC; T8788)",
 		UserAgent{
-			Browser{BrowserIE, Version{7, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{7, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (compatible; MSIE 9.0; Windows Phone OS 7.5; Trident/5.0; IEMobile/9.0)",
 		UserAgent{
-			Browser{BrowserIE, Version{9, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{7, 5, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 9, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 7, Minor: 5, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (compatible; MSIE 10.0; Windows Phone 8.0; Trident/6.0; IEMobile/10.0; ARM; Touch; NOKIA; Lumia 920)",
 		UserAgent{
-			Browser{BrowserIE, Version{10, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{8, 0, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 8, Minor: 0, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Windows Phone 8.1; ARM; Trident/7.0; Touch IEMobile/11.0; HTC; Windows Phone 8S by HTC) like Gecko",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{8, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 8, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (Windows Phone 8.1; ARM; Trident/7.0; Touch IEMobile/11.0; NOKIA; 909) like Gecko",
 		UserAgent{
-			Browser{BrowserIE, Version{11, 0, 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{8, 1, 0}}, DevicePhone}},
+			Browser{Name: BrowserIE, Version: Version{Major: 11, Minor: 0, Patch: 0}}, OS{PlatformWindowsPhone, OSWindowsPhone, Version{Major: 8, Minor: 1, Patch: 0}}, DevicePhone}},
 
 	{"Mozilla/5.0 (compatible; MSIE 9.0; Windows NT 6.1; Trident/5.0; Xbox)",
 		UserAgent{
-			Browser{BrowserIE, Version{9, 0, 0}}, OS{PlatformXbox, OSXbox, Version{6, 1, 0}}, DeviceConsole}},
+			Browser{Name: BrowserIE, Version: Version{Major: 9, Minor: 0, Patch: 0}}, OS{PlatformXbox, OSXbox, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceConsole}},
 	{"Mozilla/5.0 (Windows NT 6.3; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) coc_coc_browser/42.0 CoRom/36.0.1985.144 Chrome/36.0.1985.144 Safari/537.36",
 		UserAgent{
-			Browser{BrowserCocCoc, Version{42, 0, 0}}, OS{PlatformWindows, OSWindows, Version{6, 3, 0}}, DeviceComputer}},
+			Browser{Name: BrowserCocCoc, Version: Version{Major: 42, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 3, Patch: 0}}, DeviceComputer}},
 	{"Mozilla/5.0 (compatible; coccocbot/1.0; +http://help.coccoc.com/searchengine)",
 		UserAgent{
-			Browser{BrowserCocCocBot, Version{0, 0, 0}}, OS{PlatformBot, OSBot, Version{0, 0, 0}}, DeviceComputer}},
+			Browser{Name: BrowserCocCocBot, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformBot, OSBot, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
 
 	{"Mozilla/5.0 (Linux; Android 4.4.4; SM-T560 Build/KTU84P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/63.0.3239.111 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{63, 0, 3239}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 4}}, DeviceTablet}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 63, Minor: 0, Patch: 3239}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 4}}, DeviceTablet}},
 	{"Mozilla/5.0 (Linux; Android 5.1.1; KFSUWI) AppleWebKit/537.36 (KHTML, like Gecko) Silk/70.4.2 like Chrome/70.0.3538.80 Safari/537.36",
 		UserAgent{
-			Browser{BrowserSilk, Version{70, 4, 2}}, OS{PlatformLinux, OSAndroid, Version{5, 1, 1}}, DeviceTablet}},
+			Browser{Name: BrowserSilk, Version: Version{Major: 70, Minor: 4, Patch: 2}}, OS{PlatformLinux, OSAndroid, Version{Major: 5, Minor: 1, Patch: 1}}, DeviceTablet}},
 	{"Mozilla/5.0 (Linux; Android 4.4.2; T1-701u Build/HuaweiMediaPad) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/64.0.3282.123 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{64, 0, 3282}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 2}}, DeviceTablet}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 64, Minor: 0, Patch: 3282}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 2}}, DeviceTablet}},
 	{"Mozilla/5.0 (Linux; Android 4.4.2; Lenovo TAB 2 A7-30F Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/45.0.2454.84 Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{45, 0, 2454}}, OS{PlatformLinux, OSAndroid, Version{4, 4, 2}}, DeviceTablet}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 45, Minor: 0, Patch: 2454}}, OS{PlatformLinux, OSAndroid, Version{Major: 4, Minor: 4, Patch: 2}}, DeviceTablet}},
 	{"Mozilla/5.0 (X11; Linux armv7l) AppleWebKit/537.36 (KHTML, like Gecko) QtWebEngine/5.9.7 Chrome/56.0.2924.122 Safari/537.36 Sky_STB_BC7445_2018/1.0.0 (Sky, ES140UK, )",
 		UserAgent{
-			Browser{BrowserChrome, Version{56, 0, 2924}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 56, Minor: 0, Patch: 2924}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (ARRIS_Foxtel_STB_DGX7000NF; Linux mipsel) AppleWebKit/605.1.15 (KHTML, like Gecko) WPE ARRIS_Foxtel_STB_DGX7000NF /1.21.3.9 (Foxtel,DGX7000NF)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux armv7l) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/77.0. 3865.120 Safari/537.36 OPR/46.0.2207.0 OMI/4.20.5.80.Catcher3.128 Model/Hisense-MT9602 VIDAA/4.0(Hisense;SmartTV;32A35EUV_0002;MTK9602/V0000.01.00K.M0713;HD)",
 		UserAgent{
-			Browser{BrowserOpera, Version{46, 0, 2207}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 46, Minor: 0, Patch: 2207}}, OS{PlatformLinux, OSVIDAA, Version{Major: 4, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Web0S; Linux/SmartTV) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/53.0.2785.34 Safari/537.36 WebAppManager",
 		UserAgent{
-			Browser{BrowserChrome, Version{53, 0, 2785}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 53, Minor: 0, Patch: 2785}}, OS{PlatformLinux, OSWebOSTV, Version{Major: 4, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (PlayStation 4 WebMAF) AppleWebKit/601.2 (KHTML, like Gecko) WebMAF/v3.0.2-0-g0f0b69bc SDK: (0x09508001u), Built: Aug 17 2022 20:04:00",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformPlaystation, OSPlaystation, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformPlaystation, OSPlaystation, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 	{"Mozilla/5.0 (PlayStation; PlayStation 5/6.00) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.4 Safari/605.1.15",
 		UserAgent{
-			Browser{BrowserSafari, Version{15, 4, 0}}, OS{PlatformPlaystation, OSPlaystation, Version{0, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserSafari, Version: Version{Major: 15, Minor: 4, Patch: 0}}, OS{PlatformPlaystation, OSPlaystation, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceConsole}},
 	{"Mozilla/5.0 (Linux; Tizen 2.3; SmartHub; SMART-TV; SmartTV; U; Maple2012) AppleWebKit/538.1+ (KHTML, like Gecko) TV Safari/538.1+",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSTizen, Version{Major: 2, Minor: 3, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Andr0id 12; IP2300) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.5735.198 Safari/537.36 OPR/46.0.2207.0 OMI/4.24.0.81.CRON5.4 Model/Swisscom-IP2300",
 		UserAgent{
-			Browser{BrowserOpera, Version{46, 0, 2207}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 46, Minor: 0, Patch: 2207}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux ) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/108.0.5359.128 Safari/537.36 OPR/46.0.2207.0 OMI/4.23.2.96.LIMA2.71 Model/Vestel-MB180 VSTVB MB100 FVC/8.0 (OEM; MB180; ) HbbTV/1.6.1 (+DRM; OEM; MB180; 0.20.0.0; ; _TV_G31_2023;) TiVoOS/1.0.0 (Vestel MB180 OEM) SmartTvA/3.0.0",
 		UserAgent{
-			Browser{BrowserOpera, Version{46, 0, 2207}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 46, Minor: 0, Patch: 2207}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux armv7l) AppleWebKit/602.1.28+ (KHTML, like Gecko) Version/9.1 Safari/601.5.17 WPE/2.22.1, VirginMediaSTB/VIP5002W-mon-web-00.01-148-ae-AL-20220707135023-na001 (Arris_liberty,VIP5002W-PRD,Wireless) HZN/4.43 (MN=VIP5002W-PRD;PC=APLSTB;FV=VIP5002W-mon-web-00.01-148-ae-AL-20220707135023-na001;)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{9, 1, 0}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 9, Minor: 1, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (X11; Linux armv7l) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/72.0.3626.121 Safari/537.36 CrKey/1.0.999999 VIZIO SmartCast(Conjure/SX7A-4.6.419.12 FW/11.0.120.1-1 Model/M55-E0)",
 		UserAgent{
-			Browser{BrowserChrome, Version{72, 0, 3626}}, OS{PlatformLinux, OSLinux, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 72, Minor: 0, Patch: 3626}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0, AppleWebKit/537.36, Chrome/92.0.4515.159, Safari/537.36, OPR/46.0.2207.0, OMI/4.22.1, VODAFONE_STB/7.2.A102.99ba.ngbd BCM7271/7.2.A102.99ba.ngbd/DCIW387/HIGH (Sagemcom_Broadband_SAS, DCIW387_UHD_VF_DE, Wired)",
 		UserAgent{
-			Browser{BrowserOpera, Version{46, 0, 2207}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserOpera, Version: Version{Major: 46, Minor: 0, Patch: 2207}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML; like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041",
 		UserAgent{
-			Browser{BrowserIE, Version{18, 19041, 0}}, OS{PlatformXbox, OSXbox, Version{10, 0, 0}}, DeviceConsole}},
+			Browser{Name: BrowserIE, Version: Version{Major: 18, Minor: 19041, Patch: 0}}, OS{PlatformXbox, OSXbox, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceConsole}},
 	{"YouViewHTML/1.0 AppleWebKit/605.1.15 (Sagemcom; RTIW387; RTIW387.002.P; CDS/0.6.216; API/4.0.0; PS/4.14.4) (+DVR+HTML+IPCMC+UHD+DASH+DRM)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformUnknown, OSUnknown, Version{0, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4_1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.67",
 		UserAgent{
-			Browser{BrowserIE, Version{124, 0, 2478}}, OS{PlatformMac, OSMacOSX, Version{14, 4, 1}}, DeviceComputer}},
+			Browser{Name: BrowserIE, Version: Version{Major: 124, Minor: 0, Patch: 2478}}, OS{PlatformMac, OSMacOSX, Version{Major: 14, Minor: 4, Patch: 1}}, DeviceComputer}},
 
 	// Additional TV user agents
 	{"Mozilla/5.0 (Linux; Android 11; AFTKRT Build/RS8133.2817N; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/130.0.6723.170 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{130, 0, 6723}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 130, Minor: 0, Patch: 6723}}, OS{PlatformLinux, OSFireOS, Version{Major: 8, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 9; AFTSSS Build/PS7690.4719N; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/130.0.6723.170 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{130, 0, 6723}}, OS{PlatformLinux, OSAndroid, Version{9, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 130, Minor: 0, Patch: 6723}}, OS{PlatformLinux, OSFireOS, Version{Major: 7, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 10; BRAVIA 4K UR3 Build/QTG3.200305.006.S73; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/135.0.7049.38 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{135, 0, 7049}}, OS{PlatformLinux, OSAndroid, Version{10, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 135, Minor: 0, Patch: 7049}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Dalvik/2.1.0 (Linux; U; Android 9; MIBOX4 Build/PI)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{9, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 9, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; Chromecast Build/STTL.241013.003; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/135.0.7049.38 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{135, 0, 7049}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 135, Minor: 0, Patch: 7049}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Dalvik/2.1.0 (Linux; U; Android 8.0.0; IP100 Build/OPR5.170623.014; Sky) OTTera/14.957 Motorvision",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{8, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 8, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; OTT-G1 Build/ST; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/130.0.6723.108 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{130, 0, 6723}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 130, Minor: 0, Patch: 6723}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Dalvik/2.1.0 (Linux; U; Android 12; Chromecast HD Build/STTL.240812.006)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 10; BRAVIA 4K VH21 Build/QTG3.200305.006.S416; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/134.0.6998.135 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{134, 0, 6998}}, OS{PlatformLinux, OSAndroid, Version{10, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 134, Minor: 0, Patch: 6998}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 11; TPM191E Build/RTT2.211108.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/135.0.7049.38 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{135, 0, 7049}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 135, Minor: 0, Patch: 7049}}, OS{PlatformLinux, OSAndroid, Version{Major: 11, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Dalvik/2.1.0 (Linux; U; Android 11; BRAVIA TL Build/RTM2.210929.098)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 11, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; Nokia Streaming Box 8000 Build/SC; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/135.0.7049.37 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{135, 0, 7049}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 135, Minor: 0, Patch: 7049}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"waipu/2025.1.0-49c4c93e14 (Tablet; Google; MBOX; waipu; Android 10)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{10, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"waipu/2025.5.0-16b788cf99 (Tablet; RockChip; X88Pro13.smartTV.skw.F1010_1.0.0; o2; Android 13)",
 		UserAgent{
-			Browser{BrowserUnknown, Version{0, 0, 0}}, OS{PlatformLinux, OSAndroid, Version{13, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 13, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 11; TY55_1 Build/Oldsmobile-ota-1.5.4-8654-f2098ffa2a-TY55_1KM-user-25122; wv) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/127.0.6533.120 Safari/537.36 OMI/4.25.1.92.StableAVB_Telly)",
 		UserAgent{
-			Browser{BrowserChrome, Version{127, 0, 6533}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 127, Minor: 0, Patch: 6533}}, OS{PlatformLinux, OSAndroid, Version{Major: 11, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 9; X96Max Build/PPR1.180610.011; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/83.0.4103.120 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{83, 0, 4103}}, OS{PlatformLinux, OSAndroid, Version{9, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 83, Minor: 0, Patch: 4103}}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; Vectra 4K Box Build/ST; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 10; CANAL PLUS BOX Build/QTT8.201201.002; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{10, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; Orange PL DIW377 Build/STT5.250117.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 9; DCTIW362_PLAY Build/PTT1.190826.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.60 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{9, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 8.0.0; TPM171E Build/OC; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36;dailymotion-player-sdk-android 0.2.13",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{8, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 8, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 11; GD1 4K Build/RT; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.127 Mobile Safari/537.36;dailymotion-player-sdk-android 0.2.13",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 11, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 11; AI PONT Build/RTM6.230109.082; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{11, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 11, Minor: 0, Patch: 0}}, DeviceTV}},
 	{"Mozilla/5.0 (Linux; Android 12; B-STREAM Build/STTC.230104.002; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/136.0.7103.125 Mobile Safari/537.36",
 		UserAgent{
-			Browser{BrowserChrome, Version{136, 0, 7103}}, OS{PlatformLinux, OSAndroid, Version{12, 0, 0}}, DeviceTV}},
+			Browser{Name: BrowserChrome, Version: Version{Major: 136, Minor: 0, Patch: 7103}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
+
+	// TV operating systems
+	{"AppleCoreMedia/1.0.0.21J354 (Apple TV; U; CPU OS 17_0 like Mac OS X; en_us)",
+		UserAgent{
+			Browser{Name: BrowserAppleCoreMedia, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformAppleTV, OStvOS, Version{Major: 17, Minor: 0, Patch: 0}}, DeviceTV}},
+	{"AppleTV6,2/11.1",
+		UserAgent{
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformAppleTV, OStvOS, Version{Major: 11, Minor: 1, Patch: 0}}, DeviceTV}},
+	{"Mozilla/5.0 (SMART-TV; LINUX; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) 76.0.3809.146/6.0 TV Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSTizen, Version{Major: 6, Minor: 0, Patch: 0}}, DeviceTV}},
+	{"Mozilla/5.0 (Web0S; Linux/SmartTV) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36 WebAppManager",
+		UserAgent{
+			Browser{Name: BrowserChrome, Version: Version{Major: 87, Minor: 0, Patch: 4280}}, OS{PlatformLinux, OSWebOSTV, Version{Major: 22, Minor: 0, Patch: 0}}, DeviceTV}},
+	{"Mozilla/5.0 (Linux; Android 12; SHIELD Android TV Build/SR1A.211012.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.230 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserChrome, Version: Version{Major: 120, Minor: 0, Patch: 6099}}, OS{PlatformLinux, OSAndroidTV, Version{Major: 12, Minor: 0, Patch: 0}}, DeviceTV}},
+	{"Mozilla/5.0 (Linux; Android 7.1.2; AFTMM Build/NS6265; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/70.0.3538.110 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserChrome, Version: Version{Major: 70, Minor: 0, Patch: 3538}}, OS{PlatformLinux, OSFireOS, Version{Major: 6, Minor: 0, Patch: 0}}, DeviceTV}},
+
+	// HarmonyOS and OpenHarmony
+	{"Mozilla/5.0 (Linux; Android 10; HarmonyOS; ELS-AN00; HMSCore 6.1.0.305) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/88.0.4324.93 HuaweiBrowser/11.1.1.310 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserHuawei, Version: Version{Major: 11, Minor: 1, Patch: 1}}, OS{PlatformLinux, OSHarmonyOS, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; Android 10; HarmonyOS 2.0.0; NOH-AN00 Build/HUAWEINOH-AN00; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/88.0.4324.93 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserChrome, Version: Version{Major: 88, Minor: 0, Patch: 4324}}, OS{PlatformLinux, OSHarmonyOS, Version{Major: 2, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Phone; OpenHarmony 4.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36 ArkWeb/4.1.6.1 Mobile HuaweiBrowser/5.0.4.300",
+		UserAgent{
+			Browser{Name: BrowserHuawei, Version: Version{Major: 5, Minor: 0, Patch: 4}}, OS{PlatformLinux, OSOpenHarmony, Version{Major: 4, Minor: 1, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Tablet; OpenHarmony 5.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36 ArkWeb/4.1.6.1 Mobile",
+		UserAgent{
+			Browser{Name: BrowserArkWeb, Version: Version{Major: 4, Minor: 1, Patch: 6}}, OS{PlatformLinux, OSOpenHarmony, Version{Major: 5, Minor: 0, Patch: 0}}, DeviceTablet}},
+
+	// Feature phones
+	{"Mozilla/5.0 (Mobile; Nokia_8110_4G; rv:48.0) Gecko/48.0 Firefox/48.0 KAIOS/2.5",
+		UserAgent{
+			Browser{Name: BrowserFirefox, Version: Version{Major: 48, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKaiOS, Version{Major: 2, Minor: 5, Patch: 0}}, DeviceFeaturePhone}},
+	{"Mozilla/5.0 (Mobile; LYF/F300B/LYF-F300B-001-01-15-130718-i;Android; rv:48.0) Gecko/48.0 Firefox/48.0 KAIOS/2.5",
+		UserAgent{
+			Browser{Name: BrowserFirefox, Version: Version{Major: 48, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSKaiOS, Version{Major: 2, Minor: 5, Patch: 0}}, DeviceFeaturePhone}},
+	{"Nokia6300/2.0 (05.00) Profile/MIDP-2.0 Configuration/CLDC-1.1",
+		UserAgent{
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSSeries40, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone}},
+	{"LG-GB110/V10a Obigo/WAP2.0 Profile/MIDP-2.1 Configuration/CLDC-1.1",
+		UserAgent{
+			Browser{Name: BrowserUnknown, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformUnknown, OSJ2ME, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceFeaturePhone}},
+
+	// Chromium derivatives
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Vivaldi/6.5.3206.48",
+		UserAgent{
+			Browser{Name: BrowserVivaldi, Version: Version{Major: 6, Minor: 5, Patch: 3206}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.217 Whale/3.25.232.19 Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserWhale, Version: Version{Major: 3, Minor: 25, Patch: 232}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36 OPX/2.3",
+		UserAgent{
+			Browser{Name: BrowserOperaGX, Version: Version{Major: 2, Minor: 3, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_6) AppleWebKit/537.36 (KHTML, like Gecko) Brave Chrome/67.0.3396.87 Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserBrave, Version: Version{Major: 67, Minor: 0, Patch: 3396}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 12, Patch: 6}}, DeviceComputer}},
+	{"Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.230 Mobile Safari/537.36 DuckDuckGo/5",
+		UserAgent{
+			Browser{Name: BrowserDuckDuckGo, Version: Version{Major: 5, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 14, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 DuckDuckGo/7 Safari/605.1.15",
+		UserAgent{
+			Browser{Name: BrowserDuckDuckGo, Version: Version{Major: 7, Minor: 0, Patch: 0}}, OS{PlatformiPhone, OSiOS, Version{Major: 17, Minor: 2, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15 Ddg/17.2",
+		UserAgent{
+			Browser{Name: BrowserDuckDuckGo, Version: Version{Major: 17, Minor: 2, Patch: 0}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 15, Patch: 7}}, DeviceComputer}},
+	{"Mozilla/5.0 (Linux; Android 13; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.230 Mobile Safari/537.36 (Ecosia android@120.0.6099.230)",
+		UserAgent{
+			Browser{Name: BrowserEcosia, Version: Version{Major: 120, Minor: 0, Patch: 6099}}, OS{PlatformLinux, OSAndroid, Version{Major: 13, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Arc/1.21.1",
+		UserAgent{
+			Browser{Name: BrowserArc, Version: Version{Major: 1, Minor: 21, Patch: 1}}, OS{PlatformMac, OSMacOSX, Version{Major: 10, Minor: 15, Patch: 7}}, DeviceComputer}},
+
+	// Firefox forks
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Firefox/115.0 Waterfox/G5.1.8",
+		UserAgent{
+			Browser{Name: BrowserWaterfox, Version: Version{Major: 5, Minor: 1, Patch: 8}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0 Waterfox/G6.0.5",
+		UserAgent{
+			Browser{Name: BrowserWaterfox, Version: Version{Major: 6, Minor: 0, Patch: 5}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:56.0) Gecko/20100101 Firefox/56.0 Waterfox/56.2.12",
+		UserAgent{
+			Browser{Name: BrowserWaterfox, Version: Version{Major: 56, Minor: 2, Patch: 12}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0 LibreWolf/121.0-1",
+		UserAgent{
+			Browser{Name: BrowserLibreWolf, Version: Version{Major: 121, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSLinux, Version{Major: 0, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:102.0) Gecko/20100101 Goanna/6.5 Firefox/102.0 PaleMoon/32.5.0",
+		UserAgent{
+			Browser{Name: BrowserPaleMoon, Version: Version{Major: 32, Minor: 5, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:68.0) Gecko/20100101 Goanna/4.8 Firefox/68.0 Basilisk/20230213",
+		UserAgent{
+			Browser{Name: BrowserBasilisk, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 6.1; rv:52.0) Gecko/20100101 Firefox/52.0 K-Meleon/76.4.7",
+		UserAgent{
+			Browser{Name: BrowserKMeleon, Version: Version{Major: 76, Minor: 4, Patch: 7}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Linux; Android 7.0) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Focus/4.1 Chrome/62.0.3202.84 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserFirefoxFocus, Version: Version{Major: 4, Minor: 1, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; Android 7.0) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Klar/1.0 Chrome/58.0.3029.83 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserFirefoxFocus, Version: Version{Major: 1, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 7, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Android 13; Mobile; rv:120.0) Gecko/120.0 Firefox/120.0 Focus/120.0",
+		UserAgent{
+			Browser{Name: BrowserFirefoxFocus, Version: Version{Major: 120, Minor: 0, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 13, Minor: 0, Patch: 0}}, DevicePhone}},
+	// a token merely ending in focus/
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Autofocus/1.0",
+		UserAgent{
+			Browser{Name: BrowserChrome, Version: Version{Major: 120, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+
+	// Chinese and Asian market browsers
+	{"Mozilla/5.0 (Linux; Android 10; V1936A Build/QP1A.190711.020; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/76.0.3809.89 Mobile Safari/537.36 T7/12.10 SP-engine/2.28.0 baiduboxapp/12.10.0.10 (Baidu; P1 10)",
+		UserAgent{
+			Browser{Name: BrowserBaidu, Version: Version{Major: 12, Minor: 10, Patch: 0}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/47.0.2526.108 Safari/537.36 BIDUBrowser/8.7",
+		UserAgent{
+			Browser{Name: BrowserBaidu, Version: Version{Major: 8, Minor: 7, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; 360SE; rv:11.0) like Gecko",
+		UserAgent{
+			Browser{Name: Browser360, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Linux; Android 9; MI 8 Build/PKQ1.180729.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/70.0.3538.110 Mobile Safari/537.36 QihooBrowser/4.0.10",
+		UserAgent{
+			Browser{Name: Browser360, Version: Version{Major: 4, Minor: 0, Patch: 10}}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; U; Android 12; zh-CN; PGKM10 Build/SP1A.210812.016) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/100.0.4896.58 Quark/6.2.2.246 Mobile Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserQuark, Version: Version{Major: 6, Minor: 2, Patch: 2}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; U; Android 13; zh-cn; 2211133C Build/TKQ1.220905.001) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/100.0.4896.127 Mobile Safari/537.36 XiaoMi/MiuiBrowser/17.8.120629",
+		UserAgent{
+			Browser{Name: BrowserMIUI, Version: Version{Major: 17, Minor: 8, Patch: 120629}}, OS{PlatformLinux, OSAndroid, Version{Major: 13, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; Android 12; V2154A; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/87.0.4280.141 Mobile Safari/537.36 VivoBrowser/10.3.8.0",
+		UserAgent{
+			Browser{Name: BrowserVivo, Version: Version{Major: 10, Minor: 3, Patch: 8}}, OS{PlatformLinux, OSAndroid, Version{Major: 12, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; U; Android 11; zh-cn; PDYM20 Build/RP1A.200720.011) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/70.0.3538.80 Mobile Safari/537.36 HeyTapBrowser/40.7.29.1",
+		UserAgent{
+			Browser{Name: BrowserHeyTap, Version: Version{Major: 40, Minor: 7, Patch: 29}}, OS{PlatformLinux, OSAndroid, Version{Major: 11, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/49.0.2623.221 Safari/537.36 LBBROWSER",
+		UserAgent{
+			Browser{Name: BrowserLiebao, Version: Version{Major: 0, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 6, Minor: 1, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/69.0.3497.100 Safari/537.36 2345Explorer/10.0.0.19262",
+		UserAgent{
+			Browser{Name: Browser2345, Version: Version{Major: 10, Minor: 0, Patch: 0}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/78.0.3904.108 UBrowser/6.2.4098.3 Safari/537.36",
+		UserAgent{
+			Browser{Name: BrowserUCBrowser, Version: Version{Major: 6, Minor: 2, Patch: 4098}}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	// Proxy browsers
+	{"Opera/9.80 (J2ME/MIDP; Opera Mini/9.80 (S60; SymbOS; Opera Mobi/23.348; U; en) Presto/2.5.25 Version/10.54",
+		UserAgent{
+			Browser{Name: BrowserOperaMini, Version: Version{Major: 9, Minor: 80, Patch: 0}, ServerRendered: true}, OS{PlatformUnknown, OSUnknown, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Opera/9.80 (Android; Opera Mini/36.2.2254/119.132; U; id) Presto/2.12.423 Version/12.16",
+		UserAgent{
+			Browser{Name: BrowserOperaMini, Version: Version{Major: 36, Minor: 2, Patch: 2254}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 0, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; U; Android 10; SM-A105F Build/QP1A.190711.020) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/89.0.4389.105 Mobile Safari/537.36 OPiM/62.0.2254.61283",
+		UserAgent{
+			Browser{Name: BrowserOperaMini, Version: Version{Major: 62, Minor: 0, Patch: 2254}}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/88.0.4324.93 Mobile Safari/537.36 Puffin/9.7.2.51367AP",
+		UserAgent{
+			Browser{Name: BrowserPuffin, Version: Version{Major: 9, Minor: 7, Patch: 2}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 10, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36 Puffin/8.4.0.42081AV",
+		UserAgent{
+			Browser{Name: BrowserPuffin, Version: Version{Major: 8, Minor: 4, Patch: 0}, ServerRendered: true}, OS{PlatformWindows, OSWindows, Version{Major: 10, Minor: 0, Patch: 0}}, DeviceComputer}},
+	{"UCWEB/2.0 (MIDP-2.0; U; Adr 9; en-US; Redmi_Note_7) U2/1.0.0 UCMini/12.12.9.1226 (SpeedMode; Proxy; Android 9) U2/1.0.0 Mobile",
+		UserAgent{
+			Browser{Name: BrowserUCMini, Version: Version{Major: 12, Minor: 12, Patch: 9}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DevicePhone}},
+	{"Mozilla/5.0 (Linux; Android 9; KFTRWI) AppleWebKit/537.36 (KHTML, like Gecko) Silk/84.2.154 like Chrome/84.0.4147.125 Safari/537.36 Silk-Accelerated=true",
+		UserAgent{
+			Browser{Name: BrowserSilk, Version: Version{Major: 84, Minor: 2, Patch: 154}, ServerRendered: true}, OS{PlatformLinux, OSAndroid, Version{Major: 9, Minor: 0, Patch: 0}}, DeviceTablet}},
+}
+
+// numbers returns the major, minor and patch numbers of v, which are all
+// testUAVars specifies.
+func numbers(v Version) Version {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
 }
 
 func TestAgentSurfer(t *testing.T) {
@@ -1134,8 +1401,13 @@ func TestAgentSurfer(t *testing.T) {
 					t.Logf("agent: %s", determined.UA)
 				}
 
-				if ua.Browser.Version != determined.Browser.Version {
-					t.Errorf("browser version: got %d, wanted %d", ua.Browser.Version, determined.Browser.Version)
+				if numbers(ua.Browser.Version) != determined.Browser.Version {
+					t.Errorf("browser version: got %v, wanted %v", ua.Browser.Version, determined.Browser.Version)
+					t.Logf("agent: %s", determined.UA)
+				}
+
+				if ua.Browser.ServerRendered != determined.Browser.ServerRendered {
+					t.Errorf("server rendered: got %v, wanted %v", ua.Browser.ServerRendered, determined.Browser.ServerRendered)
 					t.Logf("agent: %s", determined.UA)
 				}
 
@@ -1149,8 +1421,8 @@ func TestAgentSurfer(t *testing.T) {
 					t.Logf("agent: %s", determined.UA)
 				}
 
-				if ua.OS.Version != determined.OS.Version {
-					t.Errorf("os version: got %d, wanted %d", ua.OS.Version, determined.OS.Version)
+				if numbers(ua.OS.Version) != determined.OS.Version {
+					t.Errorf("os version: got %v, wanted %v", ua.OS.Version, determined.OS.Version)
 					t.Logf("agent: %s", determined.UA)
 				}
 
@@ -1262,6 +1534,133 @@ func BenchmarkParseSafariiPad(b *testing.B) {
 	}
 }
 
+func TestIsMediaPlayer(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected bool
+	}{
+		{"Spotify/8.8.12 iOS/16.0 (iPhone14,2)", true},
+		{"AppleCoreMedia/1.0.0.21A329 (iPhone; U; CPU OS 17_0 like Mac OS X; en_us)", true},
+		{"iTunes/12.8 (Macintosh; OS X 10.13.6) AppleWebKit/605.1.15", true},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_4) AppleWebKit/600.7.12 (KHTML, like Gecko) Version/8.0.7 Safari/600.7.12", false},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			if got := Parse(tc.ua).IsMediaPlayer(); got != tc.expected {
+				t.Fatalf("Expected %v, got %v for %q", tc.expected, got, tc.ua)
+			}
+		})
+	}
+}
+
+func TestIsEmailClient(t *testing.T) {
+	testCases := []struct {
+		ua     string
+		client bool
+		proxy  bool
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Thunderbird/115.3.1", true, false},
+		{"Mozilla/5.0 (Windows NT 5.1; rv:11.0) Gecko Firefox/11.0 (via ggpht.com GoogleImageProxy)", true, true},
+		{"YahooMailProxy; https://help.yahoo.com/kb/yahoo-mail-proxy-SLN28749.html", true, true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			ua := Parse(tc.ua)
+			if got := ua.IsEmailClient(); got != tc.client {
+				t.Errorf("IsEmailClient: expected %v, got %v for %q", tc.client, got, tc.ua)
+			}
+			if got := ua.IsEmailProxy(); got != tc.proxy {
+				t.Errorf("IsEmailProxy: expected %v, got %v for %q", tc.proxy, got, tc.ua)
+			}
+		})
+	}
+}
+
+func TestIsEdge(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected bool
+	}{
+		{"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko", false},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.17763", true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91", true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", false},
+	}
+
+	for _, tc := range testCases {
+		if got := Parse(tc.ua).Browser.IsEdge(); got != tc.expected {
+			t.Errorf("got %v, wanted %v for %s", got, tc.expected, tc.ua)
+		}
+	}
+}
+
+func TestFamily(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected BrowserFamily
+	}{
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.130 Safari/537.36", FamilyChromium},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Vivaldi/6.5.3206.48", FamilyChromium},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4_1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.67", FamilyChromium},
+		{"Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.1; Trident/6.0)", FamilyTrident},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041", FamilyEdgeHTML},
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/61.0.3163.79 Safari/537.36 Maxthon/5.2.1.6000", FamilyChromium},
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.25 Safari/537.36 Core/1.70.3722.400 QQBrowser/10.5.3739.400", FamilyChromium},
+		{"Mozilla/5.0 (Linux; U; Android 10; en-US; RMX1911 Build/QKQ1.200209.002) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/78.0.3904.108 UCBrowser/13.4.0.1306 Mobile Safari/537.36", FamilyChromium},
+		{"UCWEB/2.0 (Java; U; MIDP-2.0; en-US; MicromaxQ5) U2/1.0.0 UCBrowser/9.4.0.342 U2/1.0.0 Mobile", FamilyUnknown},
+		{"Opera/9.80 (Windows NT 6.1; WOW64) Presto/2.12.388 Version/12.16", FamilyPresto},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0", FamilyGecko},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:102.0) Gecko/20100101 Goanna/6.5 Firefox/102.0 PaleMoon/32.5.0", FamilyGecko},
+		{"Mozilla/5.0 (iPhone; U; CPU iPhone OS 5_1_1 like Mac OS X; en) AppleWebKit/534.46.0 (KHTML, like Gecko) CriOS/19.0.1084.60 Mobile/9B206 Safari/534.48.3", FamilyWebKit},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15 Ddg/17.2", FamilyWebKit},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", FamilyUnknown},
+
+		// Maxthon, QQ and Sogou Explorer ship on Trident as well
+		{"Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; Trident/4.0; Maxthon 2.0)", FamilyTrident},
+		{"Mozilla/5.0 (Windows NT 6.2; WOW64; Trident/7.0; Touch; .NET4.0E; .NET4.0C; .NET CLR 3.5.30729; .NET CLR 2.0.50727; .NET CLR 3.0.30729; InfoPath.3; Tablet PC 2.0; QQBrowser/7.6.21433.400; rv:11.0) like Gecko", FamilyTrident},
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.81 Safari/537.36 SE 2.X MetaSr 1.0", FamilyChromium},
+		{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 5.1; Trident/4.0; SE 2.X MetaSr 1.0)", FamilyTrident},
+
+		// Firefox Focus runs on the Android web view, and on Gecko since 2020
+		{"Mozilla/5.0 (Linux; Android 7.0) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Focus/4.1 Chrome/62.0.3202.84 Mobile Safari/537.36", FamilyChromium},
+		{"Mozilla/5.0 (Android 13; Mobile; rv:120.0) Gecko/120.0 Firefox/120.0 Focus/120.0", FamilyGecko},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			if got := Parse(tc.ua).Family(); got != tc.expected {
+				t.Fatalf("Expected %v, got %v for %q", tc.expected, got, tc.ua)
+			}
+		})
+	}
+}
+
+func TestStockWebKit(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected bool
+	}{
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_4) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.130 Safari/537.36", true},
+		{"Mozilla/5.0 (Linux; Android 4.4.2; GT-P5210 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.93 Safari/537.36", true},
+		{"Mozilla/5.0 (iPad; CPU OS 8_1_2 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) Version/8.0 Mobile/12B440 Safari/600.1.4", true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91", false},
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.81 Safari/537.36 SE 2.X MetaSr 1.0", false},
+		{"Mozilla/5.0 (Linux; Android 7.0) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Focus/4.1 Chrome/62.0.3202.84 Mobile Safari/537.36", false},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1", false},
+		{"Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.216 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", false},
+	}
+
+	for _, tc := range testCases {
+		if got := stockWebKit(normalise(tc.ua)); got != tc.expected {
+			t.Errorf("got %v, wanted %v for %s", got, tc.expected, tc.ua)
+		}
+	}
+}
+
 func TestStringTrimPrefix(t *testing.T) {
 	testCases := []struct {
 		f        func() string
@@ -1283,6 +1682,14 @@ func TestStringTrimPrefix(t *testing.T) {
 			f:        PlatformUnknown.StringTrimPrefix,
 			expected: "Unknown",
 		},
+		{
+			f:        FamilyChromium.StringTrimPrefix,
+			expected: "Chromium",
+		},
+		{
+			f:        ChannelTechnologyPreview.StringTrimPrefix,
+			expected: "TechnologyPreview",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1294,3 +1701,35 @@ func TestStringTrimPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionParse(t *testing.T) {
+	testCases := []struct {
+		ua       string
+		expected Version
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Safari/537.36",
+			Version{Major: 120, Minor: 0, Patch: 6099, Build: 109, Raw: "120.0.6099.109"}},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0b3",
+			Version{Major: 121, Minor: 0, Pre: "b3", Raw: "121.0b3"}},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:122.0) Gecko/20100101 Firefox/122.0a1",
+			Version{Major: 122, Minor: 0, Pre: "a1", Raw: "122.0a1"}},
+		// Raw keeps the case of the user agent
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0B3",
+			Version{Major: 121, Minor: 0, Pre: "b3", Raw: "121.0B3"}},
+		{"Mozilla/4.0 (compatible; MSIE 5.01; Windows NT 5.0)",
+			Version{Major: 5, Minor: 0, Patch: 1, Raw: "5.01"}},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0 Waterfox/G6.0.5",
+			Version{Major: 6, Minor: 0, Patch: 5, Raw: "6.0.5"}},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:68.0) Gecko/20100101 Goanna/4.8 Firefox/68.0 Basilisk/20230213",
+			Version{Build: 20230213, Raw: "20230213"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			ua := Parse(tc.ua)
+			if ua.Browser.Version != tc.expected {
+				t.Errorf("got %+v, wanted %+v", ua.Browser.Version, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package uasurfer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a version number as found in a user agent, e.g. 120.0.6099.109
+// or 121.0b3. Versions inferred from another one, such as Internet Explorer
+// from its Trident version, keep the Raw string they were read from.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+	Pre   string // pre-release tag or other suffix following the numbers, e.g. "b3" or "a1"
+	Raw   string // the version as it appears in the user agent
+}
+
+// Less reports whether v is lower than c. A pre-release is lower than the
+// release with the same numbers, and pre-releases are ordered by their tag,
+// so that 122.0a1 < 122.0b1 < 122.0b10 < 122.0.
+func (v Version) Less(c Version) bool {
+	if v.Major != c.Major {
+		return v.Major < c.Major
+	}
+
+	if v.Minor != c.Minor {
+		return v.Minor < c.Minor
+	}
+
+	if v.Patch != c.Patch {
+		return v.Patch < c.Patch
+	}
+
+	if v.Build != c.Build {
+		return v.Build < c.Build
+	}
+
+	switch {
+	case v.Pre == c.Pre || v.Pre == "":
+		return false
+	case c.Pre == "":
+		return true
+	}
+	return lessPre(v.Pre, c.Pre)
+}
+
+// lessPre compares pre-release tags by their leading letters, then by the
+// number following them.
+func lessPre(a, b string) bool {
+	la, lb := strings.TrimRight(a, "0123456789"), strings.TrimRight(b, "0123456789")
+	if la != lb {
+		return la < lb
+	}
+	na, nb := strings.TrimLeft(a[len(la):], "0"), strings.TrimLeft(b[len(lb):], "0")
+	if len(na) != len(nb) {
+		return len(na) < len(nb)
+	}
+	return na < nb
+}
+
+// String returns the version in the form major.minor.patch, followed by the
+// build number if there is one and the pre-release tag, e.g. "121.0.0b3".
+func (v Version) String() string {
+	return string(v.appendText(make([]byte, 0, 16)))
+}
+
+func (v Version) appendText(b []byte) []byte {
+	b = strconv.AppendInt(b, int64(v.Major), 10)
+	b = append(b, '.')
+	b = strconv.AppendInt(b, int64(v.Minor), 10)
+	b = append(b, '.')
+	b = strconv.AppendInt(b, int64(v.Patch), 10)
+	if v.Build != 0 {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(v.Build), 10)
+	}
+	return append(b, v.Pre...)
+}
+
+// Compare returns -1 if v is lower than c, 1 if it is higher and 0 if both
+// are equal, ordering versions as Less does.
+func (v Version) Compare(c Version) int {
+	switch {
+	case v.Less(c):
+		return -1
+	case c.Less(v):
+		return 1
+	}
+	return 0
+}
+
+// Equal reports whether v and c have the same numbers and pre-release tag.
+// Raw is ignored, so that 8.0 equals 8.0.0.
+func (v Version) Equal(c Version) bool {
+	return v.Compare(c) == 0
+}
+
+// AtLeast reports whether v is c or higher.
+func (v Version) AtLeast(c Version) bool {
+	return !v.Less(c)
+}
+
+// Display returns the version as the user agent reports it, with
+// underscores read as dots, e.g. "45.0" where String returns "45.0.0".
+// Versions whose Raw doesn't read as their numbers, such as Internet
+// Explorer's, inferred from its Trident version, are formatted by String. It
+// returns "" for the zero Version.
+func (v Version) Display() string {
+	if v == (Version{}) {
+		return ""
+	}
+	raw := strings.ReplaceAll(v.Raw, "_", ".")
+	var r Version
+	if !r.parse(strings.ToLower(raw)) || len(r.Raw) != len(raw) || !strings.EqualFold(r.Pre, v.Pre) || !sameNumbers(r, v) {
+		return v.String()
+	}
+	return raw
+}
+
+// sameNumbers reports whether v has the numbers r was read with, possibly
+// moved to lower fields, as Basilisk's build date is. Trailing zeros are
+// ignored, so that 45.0 reads as 45.0.0.
+func sameNumbers(r, v Version) bool {
+	rn := trimZeros([]int{r.Major, r.Minor, r.Patch, r.Build})
+	vn := trimZeros([]int{v.Major, v.Minor, v.Patch, v.Build})
+	for len(vn) > len(rn) && vn[0] == 0 {
+		vn = vn[1:]
+	}
+	if len(rn) != len(vn) {
+		return false
+	}
+	for i := range rn {
+		if rn[i] != vn[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func trimZeros(n []int) []int {
+	for len(n) > 0 && n[len(n)-1] == 0 {
+		n = n[:len(n)-1]
+	}
+	return n
+}
+
+// restoreRaw replaces Raw, which was read from the lowercased user agent
+// lower, with the same text in the user agent orig it was lowered from, so
+// that Raw keeps its case. Raw remains a substring of orig, so restoring it
+// doesn't allocate.
+func (v *Version) restoreRaw(lower, orig string) {
+	if v.Raw == "" || len(lower) != len(orig) {
+		return
+	}
+	// lowering ASCII keeps the offsets; should the text occur more than once,
+	// the first occurrence differs from the one read at most in case
+	if i := strings.Index(lower, v.Raw); i >= 0 && strings.EqualFold(orig[i:i+len(v.Raw)], v.Raw) {
+		v.Raw = orig[i : i+len(v.Raw)]
+	}
+}
+
+// ParseVersion parses a version such as "15.4", "120.0.6099.109" or
+// "121.0b3". Underscores are accepted as separators, as in iOS versions.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	trimmed := strings.TrimSpace(s)
+	str := strings.ToLower(trimmed)
+	if !v.parse(str) || len(v.Raw) != len(str) {
+		return Version{}, fmt.Errorf("uasurfer: invalid version %q", s)
+	}
+	v.restoreRaw(str, trimmed)
+	return v, nil
+}
+
+// Constraint is a set of version comparisons that must all hold, such as
+// ">=15.4, <16". Comparisons are separated by commas and use one of the
+// operators =, !=, >, >=, < and <=. A version without an operator must be
+// equal.
+type Constraint struct {
+	terms []constraintTerm
+}
+
+type constraintTerm struct {
+	op string
+	v  Version
+}
+
+// ParseConstraint parses a constraint expression, see Constraint.
+func ParseConstraint(s string) (Constraint, error) {
+	var c Constraint
+	for _, expr := range strings.Split(s, ",") {
+		expr = strings.TrimSpace(expr)
+		op := strings.TrimRight(expr, "0123456789._abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ ")
+		switch op {
+		case "":
+			op = "="
+		case "==":
+			op = "="
+		case "=", "!=", ">", ">=", "<", "<=":
+		default:
+			return Constraint{}, fmt.Errorf("uasurfer: invalid constraint %q", s)
+		}
+
+		v, err := ParseVersion(strings.TrimLeft(expr, "=!<> "))
+		if err != nil {
+			return Constraint{}, fmt.Errorf("uasurfer: invalid constraint %q", s)
+		}
+		c.terms = append(c.terms, constraintTerm{op: op, v: v})
+	}
+	return c, nil
+}
+
+// MustConstraint is like ParseConstraint but panics if the expression
+// cannot be parsed. It simplifies declaring constraints in package variables.
+func MustConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Check reports whether v satisfies every comparison of the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, t := range c.terms {
+		cmp := v.Compare(t.v)
+		var ok bool
+		switch t.op {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,179 @@
+package uasurfer
+
+import "testing"
+
+func TestVersionLess(t *testing.T) {
+	ordered := []Version{
+		{Major: 121, Minor: 0},
+		{Major: 121, Minor: 0, Patch: 6099},
+		{Major: 121, Minor: 0, Patch: 6099, Build: 109},
+		{Major: 122, Minor: 0, Pre: "a1"},
+		{Major: 122, Minor: 0, Pre: "b2"},
+		{Major: 122, Minor: 0, Pre: "b10"},
+		{Major: 122, Minor: 0},
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			if got := ordered[i].Less(ordered[j]); got != (i < j) {
+				t.Errorf("%v.Less(%v): got %v, wanted %v", ordered[i], ordered[j], got, i < j)
+			}
+		}
+	}
+
+	// AppleCoreMedia follows its version with the OS build, which isn't part
+	// of it
+	iOS17 := Parse("AppleCoreMedia/1.0.0.21A329 (iPhone; U; CPU OS 17_0 like Mac OS X; en_us)").Browser.Version
+	tvOS17 := Parse("AppleCoreMedia/1.0.0.21J354 (Apple TV; U; CPU OS 17_0 like Mac OS X; en_us)").Browser.Version
+	if want := (Version{Major: 1, Raw: "1.0.0"}); iOS17 != want {
+		t.Errorf("AppleCoreMedia version: got %+v, wanted %+v", iOS17, want)
+	}
+	if iOS17.Less(tvOS17) || tvOS17.Less(iOS17) || iOS17.Less(Version{Major: 1}) || !iOS17.Less(Version{Major: 1, Patch: 1}) {
+		t.Errorf("AppleCoreMedia versions %v and %v are ordered by their OS build", iOS17, tvOS17)
+	}
+}
+
+func TestVersionString(t *testing.T) {
+	testCases := []struct {
+		v        Version
+		expected string
+	}{
+		{Version{Major: 45, Minor: 0, Patch: 2454}, "45.0.2454"},
+		{Version{Major: 120, Minor: 0, Patch: 6099, Build: 109}, "120.0.6099.109"},
+		{Version{Major: 121, Pre: "b3"}, "121.0.0b3"},
+		{Version{}, "0.0.0"},
+	}
+
+	for _, tc := range testCases {
+		if s := tc.v.String(); s != tc.expected {
+			t.Errorf("got %q, wanted %q", s, tc.expected)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	a := Version{Major: 8, Minor: 0, Raw: "8.0"}
+	b := Version{Major: 8, Minor: 0, Patch: 0, Raw: "8.0.0"}
+	c := Version{Major: 8, Minor: 0, Patch: 1}
+
+	if a.Compare(b) != 0 || !a.Equal(b) {
+		t.Errorf("%v and %v should be equal", a, b)
+	}
+	if a.Compare(c) != -1 || c.Compare(a) != 1 || a.Equal(c) {
+		t.Errorf("%v should be lower than %v", a, c)
+	}
+	if !c.AtLeast(a) || !a.AtLeast(b) || a.AtLeast(c) {
+		t.Errorf("AtLeast: unexpected result comparing %v and %v", a, c)
+	}
+}
+
+func TestVersionDisplay(t *testing.T) {
+	testCases := []struct {
+		v        Version
+		expected string
+	}{
+		{Version{Major: 45, Raw: "45.0"}, "45.0"},
+		{Version{Major: 10, Minor: 15, Patch: 7, Raw: "10_15_7"}, "10.15.7"},
+		{Version{Major: 121, Pre: "b3", Raw: "121.0b3"}, "121.0b3"},
+		{Version{Major: 121, Pre: "b3", Raw: "121.0B3"}, "121.0B3"},
+		{Version{Major: 2965, Pre: "ap", Raw: "2965AP"}, "2965AP"}, // Puffin
+		{Version{Build: 20230213, Raw: "20230213"}, "20230213"},    // Basilisk
+		{Version{Major: 0, Minor: 5, Raw: "0.5"}, "0.5"},
+		{Version{Major: 11, Raw: "7.0"}, "11.0.0"}, // inferred from Trident
+		{Version{Major: 1, Raw: "0.1"}, "1.0.0"},
+		{Version{Major: 121, Raw: "121.0b3"}, "121.0.0"},
+		{Version{Major: 16}, "16.0.0"},
+		{Version{}, ""},
+	}
+
+	for _, tc := range testCases {
+		if s := tc.v.Display(); s != tc.expected {
+			t.Errorf("%+v: got %q, wanted %q", tc.v, s, tc.expected)
+		}
+	}
+
+	agents := []struct {
+		ua       string
+		expected string
+	}{
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0b3", "121.0b3"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:68.0) Gecko/20100101 Goanna/4.8 Firefox/68.0 Basilisk/20230213", "20230213"},
+		{"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/88.0.4324.93 Mobile Safari/537.36 Puffin/9.7.2.51367AP", "9.7.2.51367AP"},
+		{"Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko", "11.0.0"}, // inferred from Trident
+	}
+
+	for _, tc := range agents {
+		if s := Parse(tc.ua).Browser.Version.Display(); s != tc.expected {
+			t.Errorf("%s: got %q, wanted %q", tc.ua, s, tc.expected)
+		}
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		s        string
+		expected Version
+		ok       bool
+	}{
+		{"15.4", Version{Major: 15, Minor: 4, Raw: "15.4"}, true},
+		{"120.0.6099.109", Version{Major: 120, Patch: 6099, Build: 109, Raw: "120.0.6099.109"}, true},
+		{"121.0B3", Version{Major: 121, Pre: "b3", Raw: "121.0B3"}, true},
+		{"17_2_1", Version{Major: 17, Minor: 2, Patch: 1, Raw: "17_2_1"}, true},
+		{" 16 ", Version{Major: 16, Raw: "16"}, true},
+		{"", Version{}, false},
+		{"v16", Version{}, false},
+		{"16.x", Version{}, false},
+		{"1.2.3.4.5", Version{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			v, err := ParseVersion(tc.s)
+			if (err == nil) != tc.ok {
+				t.Fatalf("Expected ok %v, got error %v", tc.ok, err)
+			}
+			if v != tc.expected {
+				t.Errorf("got %+v, wanted %+v", v, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		v          string
+		expected   bool
+	}{
+		{">=15.4, <16", "15.4", true},
+		{">=15.4, <16", "15.6.1", true},
+		{">=15.4, <16", "15.3", false},
+		{">=15.4, <16", "16.0", false},
+		{">=15.4, <16", "16.0b1", true},
+		{"> 100", "100.0.1", true},
+		{"<=100", "100.0.1", false},
+		{"!=11, =11.0.1", "11.0.1", true},
+		{"==11", "11.0", true},
+		{"11", "11.1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.constraint, func(t *testing.T) {
+			v, err := ParseVersion(tc.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := MustConstraint(tc.constraint).Check(v); got != tc.expected {
+				t.Errorf("Check(%s): got %v, wanted %v", tc.v, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	for _, s := range []string{"", ">=", "=>15", ">=15.4,", "~15", ">=15.x"} {
+		if _, err := ParseConstraint(s); err == nil {
+			t.Errorf("ParseConstraint(%q): expected an error", s)
+		}
+	}
+}